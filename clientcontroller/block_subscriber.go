@@ -0,0 +1,210 @@
+package clientcontroller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+const (
+	// wsHandshakeTimeout is the deadline of connecting and subscribing
+	wsHandshakeTimeout = 10 * time.Second
+	// wsReadTimeout is the deadline of receiving any message from the rpc server,
+	// which pings the subscribers more frequently, after which the subscription
+	// is considered dropped
+	wsReadTimeout  = time.Minute
+	wsWriteTimeout = 10 * time.Second
+	wsEndpoint     = "/websocket"
+)
+
+// BlockSubscriber subscribes to the new blocks of the consumer chain
+type BlockSubscriber interface {
+	// SubscribeNewBlocks returns the channel of the heights of the new blocks,
+	// which is closed once the subscription drops or the context is done
+	SubscribeNewBlocks(ctx context.Context) (<-chan uint64, error)
+}
+
+// CometBFTBlockSubscriber subscribes to the NewBlock events through the
+// websocket of a CometBFT rpc server
+type CometBFTBlockSubscriber struct {
+	wsAddr string
+	nextID atomic.Uint64
+	logger *zap.Logger
+}
+
+var _ BlockSubscriber = &CometBFTBlockSubscriber{}
+
+func NewCometBFTBlockSubscriber(rpcAddr string, logger *zap.Logger) (*CometBFTBlockSubscriber, error) {
+	wsAddr, err := websocketAddr(rpcAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CometBFTBlockSubscriber{
+		wsAddr: wsAddr,
+		logger: logger,
+	}, nil
+}
+
+type wsRequest struct {
+	JSONRPC string            `json:"jsonrpc"`
+	ID      uint64            `json:"id"`
+	Method  string            `json:"method"`
+	Params  map[string]string `json:"params"`
+}
+
+type wsError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data"`
+}
+
+// newBlockEvent only decodes the height of the block in the NewBlock event
+type newBlockEvent struct {
+	Error  *wsError `json:"error"`
+	Result struct {
+		Data struct {
+			Value struct {
+				Block struct {
+					Header struct {
+						Height int64 `json:"height,string"`
+					} `json:"header"`
+				} `json:"block"`
+			} `json:"value"`
+		} `json:"data"`
+	} `json:"result"`
+}
+
+func (s *CometBFTBlockSubscriber) SubscribeNewBlocks(ctx context.Context) (<-chan uint64, error) {
+	dialer := websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: wsHandshakeTimeout,
+	}
+	conn, _, err := dialer.DialContext(ctx, s.wsAddr, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", s.wsAddr, err)
+	}
+
+	if err := s.subscribe(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	// the ping of the rpc server extends the read deadline
+	conn.SetPingHandler(func(appData string) error {
+		if err := conn.SetReadDeadline(time.Now().Add(wsReadTimeout)); err != nil {
+			return err
+		}
+		return conn.WriteControl(websocket.PongMessage, []byte(appData), time.Now().Add(wsWriteTimeout))
+	})
+
+	heights := make(chan uint64)
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+	go func() {
+		defer close(heights)
+		defer close(done)
+		defer conn.Close()
+
+		for {
+			if err := conn.SetReadDeadline(time.Now().Add(wsReadTimeout)); err != nil {
+				return
+			}
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				if ctx.Err() == nil {
+					s.logger.Debug("the new block subscription dropped",
+						zap.String("address", s.wsAddr), zap.Error(err))
+				}
+				return
+			}
+
+			var event newBlockEvent
+			if err := json.Unmarshal(msg, &event); err != nil {
+				s.logger.Debug("failed to decode the new block event", zap.Error(err))
+				continue
+			}
+			if event.Error != nil {
+				s.logger.Debug("the new block subscription failed",
+					zap.String("address", s.wsAddr), zap.String("error", event.Error.Message))
+				return
+			}
+			height := event.Result.Data.Value.Block.Header.Height
+			if height <= 0 {
+				continue
+			}
+
+			select {
+			case heights <- uint64(height):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return heights, nil
+}
+
+// subscribe sends the subscription request of the NewBlock events
+// and waits for the response
+func (s *CometBFTBlockSubscriber) subscribe(conn *websocket.Conn) error {
+	req := &wsRequest{
+		JSONRPC: "2.0",
+		ID:      s.nextID.Add(1),
+		Method:  "subscribe",
+		Params:  map[string]string{"query": cmttypes.EventQueryNewBlock.String()},
+	}
+	if err := conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout)); err != nil {
+		return err
+	}
+	if err := conn.WriteJSON(req); err != nil {
+		return fmt.Errorf("failed to subscribe to the new blocks: %w", err)
+	}
+
+	if err := conn.SetReadDeadline(time.Now().Add(wsHandshakeTimeout)); err != nil {
+		return err
+	}
+	var res newBlockEvent
+	if err := conn.ReadJSON(&res); err != nil {
+		return fmt.Errorf("failed to receive the subscription response: %w", err)
+	}
+	if res.Error != nil {
+		return fmt.Errorf("failed to subscribe to the new blocks: %s %s", res.Error.Message, res.Error.Data)
+	}
+
+	return nil
+}
+
+// websocketAddr returns the websocket address of the CometBFT rpc server
+func websocketAddr(rpcAddr string) (string, error) {
+	u, err := url.Parse(rpcAddr)
+	if err != nil {
+		return "", fmt.Errorf("invalid rpc address %s: %w", rpcAddr, err)
+	}
+
+	switch u.Scheme {
+	case "http", "tcp", "ws":
+		u.Scheme = "ws"
+	case "https", "wss":
+		u.Scheme = "wss"
+	default:
+		return "", fmt.Errorf("unsupported scheme of the rpc address %s", rpcAddr)
+	}
+	u.Path = wsEndpoint
+
+	return u.String(), nil
+}
@@ -0,0 +1,70 @@
+package clientcontroller_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/finality-provider/clientcontroller"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+// FuzzSubscribeNewBlocks tests receiving the heights of the new blocks
+// until the subscription drops
+func FuzzSubscribeNewBlocks(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		server := testutil.NewNewBlockEventServer(t)
+		subscriber, err := clientcontroller.NewCometBFTBlockSubscriber(server.RPCAddr(), zap.NewNop())
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		heights, err := subscriber.SubscribeNewBlocks(ctx)
+		require.NoError(t, err)
+		server.WaitForSubscribers(t, 1)
+
+		startHeight := uint64(r.Int63n(1000) + 1)
+		numBlocks := uint64(r.Int63n(10) + 1)
+		for h := startHeight; h < startHeight+numBlocks; h++ {
+			server.PublishNewBlock(t, int64(h))
+			select {
+			case height := <-heights:
+				require.Equal(t, h, height)
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Failed to get the new block")
+			}
+		}
+
+		// the channel is closed once the subscription drops
+		server.DropSubscriptions()
+		select {
+		case _, ok := <-heights:
+			require.False(t, ok)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("Failed to detect the dropped subscription")
+		}
+
+		// the channel is closed once the context is done
+		heights, err = subscriber.SubscribeNewBlocks(ctx)
+		require.NoError(t, err)
+		cancel()
+		select {
+		case _, ok := <-heights:
+			require.False(t, ok)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("Failed to close the subscription")
+		}
+
+		// the subscription fails if the server is unavailable
+		server.Close()
+		_, err = subscriber.SubscribeNewBlocks(context.Background())
+		require.Error(t, err)
+	})
+}
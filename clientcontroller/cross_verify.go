@@ -0,0 +1,138 @@
+package clientcontroller
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+// ErrBlockHashMismatch is returned if the rpc servers disagree on the hash of a
+// block, which means one of them is compromised or serves a fork
+var ErrBlockHashMismatch = errors.New("the rpc servers disagree on the block hash")
+
+// BlockVerifier is an rpc server that the block hashes are cross-verified with
+type BlockVerifier struct {
+	Addr string
+	CC   ClientController
+}
+
+// CrossVerifiedClientController queries the blocks from the primary client
+// controller, and only returns them once a quorum of the rpc servers, including
+// the primary one, agree on their hashes. So a single compromised rpc server
+// cannot get a forged block hash signed
+type CrossVerifiedClientController struct {
+	ClientController
+
+	verifiers []*BlockVerifier
+	quorum    uint32
+	logger    *zap.Logger
+}
+
+var _ ClientController = &CrossVerifiedClientController{}
+
+func NewCrossVerifiedClientController(
+	primary ClientController,
+	verifiers []*BlockVerifier,
+	quorum uint32,
+	logger *zap.Logger,
+) (*CrossVerifiedClientController, error) {
+	if quorum == 0 {
+		return nil, fmt.Errorf("the block quorum should be positive")
+	}
+	if int(quorum) > len(verifiers)+1 {
+		return nil, fmt.Errorf("the block quorum %d is more than the number of rpc servers %d", quorum, len(verifiers)+1)
+	}
+
+	return &CrossVerifiedClientController{
+		ClientController: primary,
+		verifiers:        verifiers,
+		quorum:           quorum,
+		logger:           logger,
+	}, nil
+}
+
+// QueryBlock queries the block at the given height and cross-verifies its hash
+func (cc *CrossVerifiedClientController) QueryBlock(height uint64) (*types.BlockInfo, error) {
+	block, err := cc.ClientController.QueryBlock(height)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cc.verifyBlocks([]*types.BlockInfo{block}); err != nil {
+		return nil, err
+	}
+
+	return block, nil
+}
+
+// QueryBlocks returns a list of blocks from startHeight to endHeight
+// and cross-verifies their hashes
+func (cc *CrossVerifiedClientController) QueryBlocks(startHeight, endHeight, limit uint64) ([]*types.BlockInfo, error) {
+	blocks, err := cc.ClientController.QueryBlocks(startHeight, endHeight, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cc.verifyBlocks(blocks); err != nil {
+		return nil, err
+	}
+
+	return blocks, nil
+}
+
+// verifyBlocks queries the verifiers in order until the quorum agrees on the hash
+// of each block. ErrBlockHashMismatch is returned as soon as a verifier returns
+// a different hash, while a verifier that fails to respond is skipped
+func (cc *CrossVerifiedClientController) verifyBlocks(blocks []*types.BlockInfo) error {
+	// the primary rpc server agrees on all the blocks
+	numAgreed := make([]uint32, len(blocks))
+	for i := range numAgreed {
+		numAgreed[i] = 1
+	}
+
+	var errs []error
+	for _, v := range cc.verifiers {
+		for i, b := range blocks {
+			if numAgreed[i] >= cc.quorum {
+				continue
+			}
+
+			vb, err := v.CC.QueryBlock(b.Height)
+			if err != nil {
+				cc.logger.Debug("failed to query the rpc server to cross-verify the block",
+					zap.String("address", v.Addr), zap.Uint64("height", b.Height), zap.Error(err))
+				errs = append(errs, fmt.Errorf("%s: %w", v.Addr, err))
+				// skip the verifier that fails to respond
+				break
+			}
+
+			if !bytes.Equal(vb.Hash, b.Hash) {
+				return fmt.Errorf("%w: the rpc server %s returns block hash %x at height %d, while the primary one returns %x",
+					ErrBlockHashMismatch, v.Addr, vb.Hash, b.Height, b.Hash)
+			}
+			numAgreed[i]++
+		}
+	}
+
+	for i, b := range blocks {
+		if numAgreed[i] < cc.quorum {
+			return fmt.Errorf("only %d rpc servers agree on the block at height %d, while the quorum is %d: %w",
+				numAgreed[i], b.Height, cc.quorum, errors.Join(errs...))
+		}
+	}
+
+	return nil
+}
+
+func (cc *CrossVerifiedClientController) Close() error {
+	errs := []error{cc.ClientController.Close()}
+	for _, v := range cc.verifiers {
+		errs = append(errs, v.CC.Close())
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,72 @@
+package clientcontroller_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/finality-provider/clientcontroller"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+	"github.com/babylonlabs-io/finality-provider/testutil/mocks"
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+// FuzzCrossVerifiedQueryBlock tests that the blocks are only returned once
+// the quorum of the rpc servers agree on their hashes
+func FuzzCrossVerifiedQueryBlock(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctl := gomock.NewController(t)
+		height := uint64(r.Int63n(1000) + 1)
+		block := &types.BlockInfo{Height: height, Hash: datagen.GenRandomByteArray(r, 32)}
+		forgedBlock := &types.BlockInfo{Height: height, Hash: datagen.GenRandomByteArray(r, 32)}
+
+		primary := mocks.NewMockClientController(ctl)
+		primary.EXPECT().QueryBlock(height).Return(block, nil).AnyTimes()
+		unavailable := mocks.NewMockClientController(ctl)
+		unavailable.EXPECT().QueryBlock(height).Return(nil, fmt.Errorf("connection refused")).AnyTimes()
+		honest := mocks.NewMockClientController(ctl)
+		honest.EXPECT().QueryBlock(height).Return(block, nil).AnyTimes()
+		forged := mocks.NewMockClientController(ctl)
+		forged.EXPECT().QueryBlock(height).Return(forgedBlock, nil).AnyTimes()
+
+		newCC := func(quorum uint32, verifiers ...clientcontroller.ClientController) *clientcontroller.CrossVerifiedClientController {
+			bvs := make([]*clientcontroller.BlockVerifier, 0, len(verifiers))
+			for i, v := range verifiers {
+				bvs = append(bvs, &clientcontroller.BlockVerifier{Addr: fmt.Sprintf("verifier-%d", i), CC: v})
+			}
+			cc, err := clientcontroller.NewCrossVerifiedClientController(primary, bvs, quorum, zap.NewNop())
+			require.NoError(t, err)
+			return cc
+		}
+
+		// the unavailable verifier is skipped
+		b, err := newCC(2, unavailable, honest).QueryBlock(height)
+		require.NoError(t, err)
+		require.Equal(t, block, b)
+
+		// the verifiers after the quorum is reached are not queried
+		_, err = newCC(2, honest, forged).QueryBlock(height)
+		require.NoError(t, err)
+
+		// the quorum cannot be reached
+		_, err = newCC(3, unavailable, honest).QueryBlock(height)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, clientcontroller.ErrBlockHashMismatch)
+
+		// a disagreeing verifier fails the query
+		_, err = newCC(3, honest, forged).QueryBlock(height)
+		require.ErrorIs(t, err, clientcontroller.ErrBlockHashMismatch)
+
+		// the quorum cannot exceed the number of rpc servers
+		_, err = clientcontroller.NewCrossVerifiedClientController(primary, nil, 2, zap.NewNop())
+		require.Error(t, err)
+	})
+}
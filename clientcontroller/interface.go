@@ -85,5 +85,34 @@ func NewClientController(chainName string, bbnConfig *fpcfg.BBNConfig, netParams
 		return nil, fmt.Errorf("unsupported consumer chain")
 	}
 
-	return cc, err
+	if len(bbnConfig.CrossVerifyRPCAddrs) == 0 {
+		return cc, nil
+	}
+
+	verifiers := make([]*BlockVerifier, 0, len(bbnConfig.CrossVerifyRPCAddrs))
+	closeAll := func() {
+		cc.Close()
+		for _, v := range verifiers {
+			v.CC.Close()
+		}
+	}
+	for _, addr := range bbnConfig.CrossVerifyRPCAddrs {
+		verifierCfg := *bbnConfig
+		verifierCfg.RPCAddr = addr
+		verifierCfg.CrossVerifyRPCAddrs = nil
+		vc, err := NewBabylonController(&verifierCfg, netParams, logger)
+		if err != nil {
+			closeAll()
+			return nil, fmt.Errorf("failed to create Babylon rpc client for %s: %w", addr, err)
+		}
+		verifiers = append(verifiers, &BlockVerifier{Addr: addr, CC: vc})
+	}
+
+	cvc, err := NewCrossVerifiedClientController(cc, verifiers, bbnConfig.BlockQuorum, logger)
+	if err != nil {
+		closeAll()
+		return nil, err
+	}
+
+	return cvc, nil
 }
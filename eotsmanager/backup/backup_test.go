@@ -0,0 +1,101 @@
+package backup
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+func FuzzShamir(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		secret := datagen.GenRandomByteArray(r, uint64(r.Intn(64)+16))
+		threshold := r.Intn(8) + 2
+		total := threshold + r.Intn(8)
+
+		shares, err := splitSecret(secret, threshold, total)
+		require.NoError(t, err)
+		require.Len(t, shares, total)
+
+		// any threshold of the shares recover the secret
+		perm := r.Perm(total)
+		subset := make([][]byte, 0, threshold)
+		for _, i := range perm[:threshold] {
+			subset = append(subset, shares[i])
+		}
+		recovered, err := combineShares(subset)
+		require.NoError(t, err)
+		require.Equal(t, secret, recovered)
+
+		// all the shares recover the secret as well
+		recovered, err = combineShares(shares)
+		require.NoError(t, err)
+		require.Equal(t, secret, recovered)
+
+		// less than threshold shares do not
+		recovered, err = combineShares(subset[:threshold-1])
+		if threshold > 2 {
+			require.NoError(t, err)
+		}
+		require.NotEqual(t, secret, recovered)
+
+		// duplicate shares are refused
+		_, err = combineShares([][]byte{shares[0], shares[0]})
+		require.Error(t, err)
+	})
+}
+
+func FuzzSplitAndRecoverPrivKey(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 3)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		privKey, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		keyName := testutil.GenRandomHexStr(r, 10)
+		passphrase := testutil.GenRandomHexStr(r, 8)
+		threshold := 2
+		total := 3
+
+		shares, err := SplitPrivKey(keyName, privKey, threshold, total, passphrase)
+		require.NoError(t, err)
+		require.Len(t, shares, total)
+
+		i := r.Intn(total)
+		j := (i + 1 + r.Intn(total-1)) % total
+		recovered, err := RecoverPrivKey([]*Share{shares[i], shares[j]}, passphrase)
+		require.NoError(t, err)
+		require.Equal(t, privKey.Serialize(), recovered.Serialize())
+
+		// less than threshold shares are refused
+		_, err = RecoverPrivKey([]*Share{shares[i]}, passphrase)
+		require.Error(t, err)
+
+		// a wrong passphrase is refused
+		_, err = RecoverPrivKey([]*Share{shares[i], shares[j]}, passphrase+"x")
+		require.Error(t, err)
+
+		// a corrupted share is detected by the checksum
+		corrupted := *shares[j]
+		corrupted.CiphertextHex = "00" + corrupted.CiphertextHex[2:]
+		if corrupted.CiphertextHex == shares[j].CiphertextHex {
+			corrupted.CiphertextHex = "01" + corrupted.CiphertextHex[2:]
+		}
+		_, err = RecoverPrivKey([]*Share{shares[i], &corrupted}, passphrase)
+		require.ErrorIs(t, err, ErrInvalidChecksum)
+
+		// shares of another backup are refused
+		otherPrivKey, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		otherShares, err := SplitPrivKey(keyName, otherPrivKey, threshold, total, passphrase)
+		require.NoError(t, err)
+		_, err = RecoverPrivKey([]*Share{shares[i], otherShares[j]}, passphrase)
+		require.Error(t, err)
+	})
+}
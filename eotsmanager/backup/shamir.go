@@ -0,0 +1,134 @@
+package backup
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// The secret is split byte by byte over GF(2^8) with the AES polynomial
+// x^8 + x^4 + x^3 + x + 1. Each share is the evaluation of the random
+// polynomials at a distinct non-zero x coordinate, which is appended as
+// the last byte of the share
+
+const maxShares = 255
+
+// splitSecret splits the secret into total shares, any threshold of which
+// can be combined to recover the secret
+func splitSecret(secret []byte, threshold, total int) ([][]byte, error) {
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("cannot split an empty secret")
+	}
+	if threshold < 2 {
+		return nil, fmt.Errorf("the threshold should be at least 2")
+	}
+	if total < threshold {
+		return nil, fmt.Errorf("the number of shares %d should not be less than the threshold %d", total, threshold)
+	}
+	if total > maxShares {
+		return nil, fmt.Errorf("the number of shares should not exceed %d", maxShares)
+	}
+
+	shares := make([][]byte, total)
+	for i := range shares {
+		shares[i] = make([]byte, len(secret)+1)
+		shares[i][len(secret)] = byte(i + 1)
+	}
+
+	coeffs := make([]byte, threshold)
+	for j, b := range secret {
+		// the constant term is the secret byte and the other coefficients are random
+		coeffs[0] = b
+		if _, err := rand.Read(coeffs[1:]); err != nil {
+			return nil, fmt.Errorf("failed to generate random coefficients: %w", err)
+		}
+		for i := range shares {
+			shares[i][j] = evalPolynomial(coeffs, byte(i+1))
+		}
+	}
+
+	return shares, nil
+}
+
+// combineShares recovers the secret from the shares through Lagrange
+// interpolation at x = 0. The result is only correct if at least the
+// threshold number of shares of the same secret are given
+func combineShares(shares [][]byte) ([]byte, error) {
+	if len(shares) < 2 {
+		return nil, fmt.Errorf("at least 2 shares are needed")
+	}
+
+	shareLen := len(shares[0])
+	if shareLen < 2 {
+		return nil, fmt.Errorf("the shares are too short")
+	}
+	xs := make([]byte, len(shares))
+	seen := make(map[byte]struct{}, len(shares))
+	for i, s := range shares {
+		if len(s) != shareLen {
+			return nil, fmt.Errorf("the shares should have the same length")
+		}
+		x := s[shareLen-1]
+		if x == 0 {
+			return nil, fmt.Errorf("invalid share index 0")
+		}
+		if _, ok := seen[x]; ok {
+			return nil, fmt.Errorf("duplicate share index %d", x)
+		}
+		seen[x] = struct{}{}
+		xs[i] = x
+	}
+
+	secret := make([]byte, shareLen-1)
+	for j := range secret {
+		var b byte
+		for i, xi := range xs {
+			// the Lagrange basis polynomial of xi evaluated at 0
+			basis := byte(1)
+			for k, xk := range xs {
+				if k == i {
+					continue
+				}
+				basis = gfMul(basis, gfDiv(xk, xk^xi))
+			}
+			b ^= gfMul(shares[i][j], basis)
+		}
+		secret[j] = b
+	}
+
+	return secret, nil
+}
+
+func evalPolynomial(coeffs []byte, x byte) byte {
+	// Horner's method
+	var y byte
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		y = gfMul(y, x) ^ coeffs[i]
+	}
+	return y
+}
+
+func gfMul(a, b byte) byte {
+	var p byte
+	for b > 0 {
+		if b&1 == 1 {
+			p ^= a
+		}
+		carry := a & 0x80
+		a <<= 1
+		if carry != 0 {
+			a ^= 0x1b
+		}
+		b >>= 1
+	}
+	return p
+}
+
+// gfDiv returns a / b, where b should not be 0
+func gfDiv(a, b byte) byte {
+	// b^254 is the multiplicative inverse of b as b^255 = 1
+	inv := byte(1)
+	for i := 0; i < 254; i++ {
+		inv = gfMul(inv, b)
+	}
+	return gfMul(a, inv)
+}
@@ -0,0 +1,230 @@
+package backup
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// ShareFormatVersion is the version of the backup share format
+const ShareFormatVersion = "1"
+
+const (
+	saltSize = 16
+
+	// scrypt parameters recommended for interactive logins
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+var (
+	// ErrInvalidChecksum The share has been corrupted
+	ErrInvalidChecksum = errors.New("invalid share checksum")
+
+	// ErrPubKeyMismatch The recovered key does not match the expected public key
+	ErrPubKeyMismatch = errors.New("the recovered key does not match the expected public key")
+)
+
+// Share is a Shamir share of the private key of an EOTS key encrypted by a
+// passphrase. Any Threshold of the Total shares recover the private key
+type Share struct {
+	Version       string `json:"version"`
+	KeyName       string `json:"key_name"`
+	PubKeyHex     string `json:"pub_key_hex"`
+	Threshold     int    `json:"threshold"`
+	Total         int    `json:"total"`
+	Index         int    `json:"index"`
+	SaltHex       string `json:"salt_hex"`
+	NonceHex      string `json:"nonce_hex"`
+	CiphertextHex string `json:"ciphertext_hex"`
+	// Checksum is the hex encoded sha256 hash of all the other fields,
+	// which detects corrupted shares before decrypting them
+	Checksum string `json:"checksum"`
+}
+
+// SplitPrivKey splits the private key of the EOTS key into total shares, any
+// threshold of which recover the key. Each share is encrypted by the passphrase
+func SplitPrivKey(keyName string, privKey *btcec.PrivateKey, threshold, total int, passphrase string) ([]*Share, error) {
+	if passphrase == "" {
+		return nil, fmt.Errorf("the backup passphrase should not be empty")
+	}
+
+	rawShares, err := splitSecret(privKey.Serialize(), threshold, total)
+	if err != nil {
+		return nil, err
+	}
+
+	pkHex := bbntypes.NewBIP340PubKeyFromBTCPK(privKey.PubKey()).MarshalHex()
+	shares := make([]*Share, 0, total)
+	for i, rawShare := range rawShares {
+		s := &Share{
+			Version:   ShareFormatVersion,
+			KeyName:   keyName,
+			PubKeyHex: pkHex,
+			Threshold: threshold,
+			Total:     total,
+			Index:     i + 1,
+		}
+		if err := s.encrypt(rawShare, passphrase); err != nil {
+			return nil, err
+		}
+		s.Checksum = s.computeChecksum()
+		shares = append(shares, s)
+	}
+
+	return shares, nil
+}
+
+// RecoverPrivKey decrypts the shares and recovers the private key from them.
+// It fails if the shares do not belong to the same key, there are less shares
+// than the threshold, or the recovered key does not match the public key
+// recorded in the shares
+func RecoverPrivKey(shares []*Share, passphrase string) (*btcec.PrivateKey, error) {
+	if len(shares) == 0 {
+		return nil, fmt.Errorf("no share is given")
+	}
+
+	first := shares[0]
+	rawShares := make([][]byte, 0, len(shares))
+	for _, s := range shares {
+		if err := s.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid share %d: %w", s.Index, err)
+		}
+		if s.PubKeyHex != first.PubKeyHex || s.Threshold != first.Threshold || s.Total != first.Total {
+			return nil, fmt.Errorf("share %d does not belong to the same backup as share %d", s.Index, first.Index)
+		}
+
+		rawShare, err := s.decrypt(passphrase)
+		if err != nil {
+			return nil, err
+		}
+		rawShares = append(rawShares, rawShare)
+	}
+
+	if len(shares) < first.Threshold {
+		return nil, fmt.Errorf("%d shares are needed to recover the key, got %d", first.Threshold, len(shares))
+	}
+
+	secret, err := combineShares(rawShares)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, _ := btcec.PrivKeyFromBytes(secret)
+	if bbntypes.NewBIP340PubKeyFromBTCPK(privKey.PubKey()).MarshalHex() != first.PubKeyHex {
+		return nil, ErrPubKeyMismatch
+	}
+
+	return privKey, nil
+}
+
+// Validate checks the format and the checksum of the share
+func (s *Share) Validate() error {
+	if s.Version != ShareFormatVersion {
+		return fmt.Errorf("unsupported share format version %s", s.Version)
+	}
+	if s.Checksum != s.computeChecksum() {
+		return ErrInvalidChecksum
+	}
+	if _, err := bbntypes.NewBIP340PubKeyFromHex(s.PubKeyHex); err != nil {
+		return fmt.Errorf("invalid public key %s: %w", s.PubKeyHex, err)
+	}
+	if s.Threshold < 2 || s.Total < s.Threshold || s.Total > maxShares {
+		return fmt.Errorf("invalid threshold %d of %d shares", s.Threshold, s.Total)
+	}
+	if s.Index < 1 || s.Index > s.Total {
+		return fmt.Errorf("invalid share index %d", s.Index)
+	}
+
+	return nil
+}
+
+func (s *Share) computeChecksum() string {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%s|%d|%d|%d|%s|%s|%s",
+		s.Version, s.KeyName, s.PubKeyHex, s.Threshold, s.Total, s.Index,
+		s.SaltHex, s.NonceHex, s.CiphertextHex)))
+
+	return hex.EncodeToString(h[:])
+}
+
+// associatedData binds the ciphertext to the backup and the index of the share
+func (s *Share) associatedData() []byte {
+	return []byte(fmt.Sprintf("%s|%d|%d|%d", s.PubKeyHex, s.Threshold, s.Total, s.Index))
+}
+
+func (s *Share) encrypt(rawShare []byte, passphrase string) error {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("failed to generate the salt: %w", err)
+	}
+
+	aead, err := newAEAD(passphrase, salt)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("failed to generate the nonce: %w", err)
+	}
+
+	s.SaltHex = hex.EncodeToString(salt)
+	s.NonceHex = hex.EncodeToString(nonce)
+	s.CiphertextHex = hex.EncodeToString(aead.Seal(nil, nonce, rawShare, s.associatedData()))
+
+	return nil
+}
+
+func (s *Share) decrypt(passphrase string) ([]byte, error) {
+	salt, err := hex.DecodeString(s.SaltHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid salt of share %d: %w", s.Index, err)
+	}
+	nonce, err := hex.DecodeString(s.NonceHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid nonce of share %d: %w", s.Index, err)
+	}
+	ciphertext, err := hex.DecodeString(s.CiphertextHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ciphertext of share %d: %w", s.Index, err)
+	}
+
+	aead, err := newAEAD(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("invalid nonce size of share %d", s.Index)
+	}
+
+	rawShare, err := aead.Open(nil, nonce, ciphertext, s.associatedData())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt share %d, the passphrase may be wrong: %w", s.Index, err)
+	}
+
+	return rawShare, nil
+}
+
+// newAEAD returns AES-256-GCM keyed by the passphrase stretched with scrypt
+func newAEAD(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive the encryption key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"crypto/tls"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/grpc/backoff"
+
+	"github.com/babylonlabs-io/finality-provider/util"
+)
+
+const (
+	DefaultCallTimeout      = 30 * time.Second
+	DefaultKeepaliveTime    = 30 * time.Second
+	DefaultKeepaliveTimeout = 10 * time.Second
+
+	// MinKeepaliveTime is the minimum interval of the keepalive pings, which
+	// is the minimum that the EOTS manager server permits
+	MinKeepaliveTime = 10 * time.Second
+)
+
+// reconnectBackoff is the backoff of reconnecting to an unavailable endpoint.
+// It is capped lower than the gRPC default so that a restarted EOTS manager
+// is picked up again quickly
+var reconnectBackoff = backoff.Config{
+	BaseDelay:  500 * time.Millisecond,
+	Multiplier: 1.6,
+	Jitter:     0.2,
+	MaxDelay:   10 * time.Second,
+}
+
+// Config is the config of the gRPC client of the EOTS manager
+type Config struct {
+	// Addresses are the endpoints of the EOTS manager in the order of preference,
+	// either TCP addresses or unix sockets, e.g., unix:///path/to/eotsd.sock.
+	// The client fails over to the next endpoint if the current one is unavailable
+	Addresses []string
+	// TLSConfig secures the connections if it is not nil
+	TLSConfig *tls.Config
+	// APIToken is attached to every request to identify the client if it is not empty
+	APIToken string
+	// CallTimeout is the deadline of each call to an endpoint
+	CallTimeout time.Duration
+	// KeepaliveTime is the interval of pinging an idle connection
+	KeepaliveTime time.Duration
+	// KeepaliveTimeout is the time to wait for the ping ack before the
+	// connection is considered broken and reconnected
+	KeepaliveTimeout time.Duration
+	// HealthObserver is notified of the health of the endpoints if it is not nil
+	HealthObserver HealthObserver
+}
+
+// HealthObserver observes the health of the EOTS manager endpoints of the client
+type HealthObserver interface {
+	// ObserveEOTSManagerHealth records whether the endpoint is responding
+	ObserveEOTSManagerHealth(endpoint string, healthy bool)
+	// ObserveEOTSManagerActiveEndpoint records the endpoint that the requests are sent
+	// to, which changes when the client fails over to another endpoint
+	ObserveEOTSManagerActiveEndpoint(endpoint string)
+}
+
+func DefaultConfig(addresses ...string) *Config {
+	return &Config{
+		Addresses:        addresses,
+		CallTimeout:      DefaultCallTimeout,
+		KeepaliveTime:    DefaultKeepaliveTime,
+		KeepaliveTimeout: DefaultKeepaliveTimeout,
+	}
+}
+
+func (cfg *Config) Validate() error {
+	if len(cfg.Addresses) == 0 {
+		return fmt.Errorf("at least one EOTS manager address should be specified")
+	}
+	seen := make(map[string]struct{}, len(cfg.Addresses))
+	for _, addr := range cfg.Addresses {
+		if addr == "" {
+			return fmt.Errorf("the EOTS manager address should not be empty")
+		}
+		if path, ok := util.UnixSocketPath(addr); ok && !filepath.IsAbs(path) {
+			return fmt.Errorf("the unix socket path of the EOTS manager address %s should be absolute", addr)
+		}
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate EOTS manager address %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+
+	if cfg.CallTimeout <= 0 {
+		return fmt.Errorf("the call timeout should be positive")
+	}
+	if cfg.KeepaliveTime < MinKeepaliveTime {
+		return fmt.Errorf("the keepalive time should be at least %v", MinKeepaliveTime)
+	}
+	if cfg.KeepaliveTimeout <= 0 {
+		return fmt.Errorf("the keepalive timeout should be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// failoverConn sends the requests to the active endpoint of the EOTS manager,
+// and fails over to the next endpoint in order if the active one is unavailable
+// or does not respond in time. Each endpoint reconnects automatically in the
+// background, so an endpoint that failed before is tried again once its turn comes
+type failoverConn struct {
+	endpoints   []*endpoint
+	callTimeout time.Duration
+	observer    HealthObserver
+
+	mu     sync.Mutex
+	active int
+}
+
+type endpoint struct {
+	addr string
+	conn *grpc.ClientConn
+}
+
+var _ grpc.ClientConnInterface = &failoverConn{}
+
+func newFailoverConn(cfg *Config, dialOpts []grpc.DialOption) (*failoverConn, error) {
+	fc := &failoverConn{
+		endpoints:   make([]*endpoint, 0, len(cfg.Addresses)),
+		callTimeout: cfg.CallTimeout,
+		observer:    cfg.HealthObserver,
+	}
+
+	for _, addr := range cfg.Addresses {
+		conn, err := grpc.Dial(addr, dialOpts...)
+		if err != nil {
+			fc.Close()
+			return nil, fmt.Errorf("failed to build gRPC connection to %s: %w", addr, err)
+		}
+		fc.endpoints = append(fc.endpoints, &endpoint{addr: addr, conn: conn})
+	}
+
+	if fc.observer != nil {
+		fc.observer.ObserveEOTSManagerActiveEndpoint(fc.endpoints[0].addr)
+	}
+
+	return fc, nil
+}
+
+// Invoke sends the request to the endpoints starting from the active one until
+// an endpoint responds, which becomes the active endpoint
+func (fc *failoverConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	start := fc.activeIndex()
+
+	var (
+		lastErr error
+		errs    []error
+	)
+	for i := 0; i < len(fc.endpoints); i++ {
+		idx := (start + i) % len(fc.endpoints)
+		ep := fc.endpoints[idx]
+
+		callCtx, cancel := context.WithTimeout(ctx, fc.callTimeout)
+		err := ep.conn.Invoke(callCtx, method, args, reply, opts...)
+		cancel()
+
+		if !isEndpointFailure(ctx, method, err) {
+			fc.markResponding(idx)
+			return err
+		}
+
+		fc.observeHealth(ep.addr, false)
+		lastErr = err
+		errs = append(errs, fmt.Errorf("%s: %w", ep.addr, err))
+
+		// the caller gives up
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	// keep the status of the error if there is a single endpoint
+	if len(errs) == 1 {
+		return lastErr
+	}
+
+	return status.Errorf(codes.Unavailable, "none of the EOTS manager endpoints is available: %v", errors.Join(errs...))
+}
+
+// NewStream opens the stream on the active endpoint without failover,
+// as the EOTS manager does not serve streaming RPCs
+func (fc *failoverConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return fc.endpoints[fc.activeIndex()].conn.NewStream(ctx, desc, method, opts...)
+}
+
+func (fc *failoverConn) Close() error {
+	var errs []error
+	for _, ep := range fc.endpoints {
+		if err := ep.conn.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (fc *failoverConn) activeIndex() int {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	return fc.active
+}
+
+func (fc *failoverConn) activeEndpoint() string {
+	return fc.endpoints[fc.activeIndex()].addr
+}
+
+// markResponding records that the endpoint responded and makes it active
+func (fc *failoverConn) markResponding(idx int) {
+	fc.mu.Lock()
+	failover := fc.active != idx
+	fc.active = idx
+	fc.mu.Unlock()
+
+	fc.observeHealth(fc.endpoints[idx].addr, true)
+	if failover && fc.observer != nil {
+		fc.observer.ObserveEOTSManagerActiveEndpoint(fc.endpoints[idx].addr)
+	}
+}
+
+func (fc *failoverConn) observeHealth(addr string, healthy bool) {
+	if fc.observer != nil {
+		fc.observer.ObserveEOTSManagerHealth(addr, healthy)
+	}
+}
+
+// idempotentMethods are the methods which can be retried against the next
+// endpoint after the active one does not respond in time, as they have no
+// effect, or the same effect, even if the active one has served them. The
+// other methods, e.g., CreateKey, would otherwise take effect on both endpoints
+var idempotentMethods = map[string]struct{}{
+	"/proto.EOTSManager/Ping":                     {},
+	"/proto.EOTSManager/GetInfo":                  {},
+	"/proto.EOTSManager/CreateRandomnessPairList": {},
+	"/proto.EOTSManager/KeyRecord":                {},
+	"/proto.EOTSManager/PubKeyRecord":             {},
+	"/proto.EOTSManager/ListKeys":                 {},
+	"/proto.EOTSManager/SignEOTS":                 {},
+	"/proto.EOTSManager/SignEOTSBatch":            {},
+	"/proto.EOTSManager/SignSchnorrSig":           {},
+	"/proto.EOTSManager/QueryAuditLog":            {},
+}
+
+// isEndpointFailure returns whether the error means the endpoint is unavailable
+// or hung, rather than the request being refused by a responding endpoint
+func isEndpointFailure(ctx context.Context, method string, err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable:
+		return true
+	case codes.DeadlineExceeded:
+		// the endpoint is hung only if it is the call timeout that expired,
+		// and the request may have been served if it is slow instead
+		_, idempotent := idempotentMethods[method]
+		return idempotent && ctx.Err() == nil
+	default:
+		return false
+	}
+}
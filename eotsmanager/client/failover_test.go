@@ -0,0 +1,143 @@
+package client_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/client"
+)
+
+// healthRecorder records the health of the endpoints observed by the client
+type healthRecorder struct {
+	mu      sync.Mutex
+	healthy map[string]bool
+	active  []string
+}
+
+func newHealthRecorder() *healthRecorder {
+	return &healthRecorder{healthy: make(map[string]bool)}
+}
+
+func (hr *healthRecorder) ObserveEOTSManagerHealth(endpoint string, healthy bool) {
+	hr.mu.Lock()
+	defer hr.mu.Unlock()
+	hr.healthy[endpoint] = healthy
+}
+
+func (hr *healthRecorder) ObserveEOTSManagerActiveEndpoint(endpoint string) {
+	hr.mu.Lock()
+	defer hr.mu.Unlock()
+	hr.active = append(hr.active, endpoint)
+}
+
+func (hr *healthRecorder) isHealthy(endpoint string) bool {
+	hr.mu.Lock()
+	defer hr.mu.Unlock()
+	return hr.healthy[endpoint]
+}
+
+func (hr *healthRecorder) activeEndpoints() []string {
+	hr.mu.Lock()
+	defer hr.mu.Unlock()
+	return append([]string{}, hr.active...)
+}
+
+func TestFailover(t *testing.T) {
+	apiVersion := eotsmanager.APIVersion
+
+	testCases := []struct {
+		name string
+		// makePrimaryFail makes the primary endpoint fail after the client is connected
+		makePrimaryFail func(stop func())
+		hang            bool
+	}{
+		{"primary stopped", func(stop func()) { stop() }, false},
+		{"primary hung", func(stop func()) {}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			primary, stopPrimary := startInfoServer(t, &infoServer{apiVersion: &apiVersion, hang: tc.hang})
+			defer stopPrimary()
+			standby, stopStandby := startInfoServer(t, &infoServer{apiVersion: &apiVersion})
+			defer stopStandby()
+
+			recorder := newHealthRecorder()
+			cfg := client.DefaultConfig(primary, standby)
+			cfg.CallTimeout = 500 * time.Millisecond
+			cfg.HealthObserver = recorder
+			require.NoError(t, cfg.Validate())
+
+			em, err := client.NewEOTSManagerGRpcClientFromConfig(cfg)
+			require.NoError(t, err)
+			defer em.Close()
+			require.Equal(t, primary, em.ActiveEndpoint())
+			require.True(t, recorder.isHealthy(primary))
+
+			tc.makePrimaryFail(stopPrimary)
+
+			// the request is served by the standby which becomes active
+			_, err = em.CheckCompatibility()
+			require.NoError(t, err)
+			require.Equal(t, standby, em.ActiveEndpoint())
+			require.False(t, recorder.isHealthy(primary))
+			require.True(t, recorder.isHealthy(standby))
+			require.Equal(t, []string{primary, standby}, recorder.activeEndpoints())
+
+			// the standby stays active
+			_, err = em.CheckCompatibility()
+			require.NoError(t, err)
+			require.Equal(t, standby, em.ActiveEndpoint())
+
+			// the request fails once none of the endpoints is available
+			stopStandby()
+			_, err = em.CheckCompatibility()
+			require.Error(t, err)
+			require.False(t, recorder.isHealthy(standby))
+		})
+	}
+}
+
+func TestInvalidClientConfig(t *testing.T) {
+	cfg := client.DefaultConfig()
+	require.ErrorContains(t, cfg.Validate(), "at least one")
+
+	cfg = client.DefaultConfig("127.0.0.1:1", "127.0.0.1:1")
+	require.ErrorContains(t, cfg.Validate(), "duplicate")
+
+	cfg = client.DefaultConfig("127.0.0.1:1")
+	cfg.KeepaliveTime = time.Second
+	require.ErrorContains(t, cfg.Validate(), "keepalive time")
+}
+
+// TestNoFailoverOnTimeoutOfNonIdempotentRequest tests that the request which
+// may have been served by the hung endpoint is not sent to the next endpoint
+func TestNoFailoverOnTimeoutOfNonIdempotentRequest(t *testing.T) {
+	apiVersion := eotsmanager.APIVersion
+	primarySrv := &infoServer{apiVersion: &apiVersion, hang: true}
+	primary, stopPrimary := startInfoServer(t, primarySrv)
+	defer stopPrimary()
+	standbySrv := &infoServer{apiVersion: &apiVersion}
+	standby, stopStandby := startInfoServer(t, standbySrv)
+	defer stopStandby()
+
+	cfg := client.DefaultConfig(primary, standby)
+	cfg.CallTimeout = 500 * time.Millisecond
+	require.NoError(t, cfg.Validate())
+
+	em, err := client.NewEOTSManagerGRpcClientFromConfig(cfg)
+	require.NoError(t, err)
+	defer em.Close()
+
+	_, err = em.CreateKey("key", "", "")
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	require.Equal(t, int32(1), primarySrv.numKeys.Load())
+	require.Zero(t, standbySrv.numKeys.Load())
+	require.Equal(t, primary, em.ActiveEndpoint())
+}
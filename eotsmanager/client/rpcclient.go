@@ -2,12 +2,18 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 
+	bbntypes "github.com/babylonlabs-io/babylon/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
 
 	"github.com/babylonlabs-io/finality-provider/eotsmanager"
 	"github.com/babylonlabs-io/finality-provider/eotsmanager/proto"
@@ -18,13 +24,52 @@ var _ eotsmanager.EOTSManager = &EOTSManagerGRpcClient{}
 
 type EOTSManagerGRpcClient struct {
 	client proto.EOTSManagerClient
-	conn   *grpc.ClientConn
+	conn   *failoverConn
 }
 
-func NewEOTSManagerGRpcClient(remoteAddr string) (*EOTSManagerGRpcClient, error) {
-	conn, err := grpc.Dial(remoteAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// NewEOTSManagerGRpcClient creates a gRPC client connected to the EOTS manager
+// at the given address with the default timeouts. The connection is secured by
+// TLS if tlsCfg is not nil, and apiToken, if not empty, is attached to every
+// request to identify the client
+func NewEOTSManagerGRpcClient(remoteAddr string, tlsCfg *tls.Config, apiToken string) (*EOTSManagerGRpcClient, error) {
+	cfg := DefaultConfig(remoteAddr)
+	cfg.TLSConfig = tlsCfg
+	cfg.APIToken = apiToken
+
+	return NewEOTSManagerGRpcClientFromConfig(cfg)
+}
+
+// NewEOTSManagerGRpcClientFromConfig creates a gRPC client connected to the
+// EOTS manager endpoints of the config. It fails if none of them responds
+func NewEOTSManagerGRpcClientFromConfig(cfg *Config) (*EOTSManagerGRpcClient, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid EOTS manager client config: %w", err)
+	}
+
+	creds := insecure.NewCredentials()
+	if cfg.TLSConfig != nil {
+		creds = credentials.NewTLS(cfg.TLSConfig)
+	}
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                cfg.KeepaliveTime,
+			Timeout:             cfg.KeepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           reconnectBackoff,
+			MinConnectTimeout: cfg.CallTimeout,
+		}),
+	}
+	if cfg.APIToken != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(tokenCredentials{token: cfg.APIToken}))
+	}
+
+	conn, err := newFailoverConn(cfg, dialOpts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to build gRPC connection to %s: %w", remoteAddr, err)
+		return nil, err
 	}
 
 	gClient := &EOTSManagerGRpcClient{
@@ -33,16 +78,44 @@ func NewEOTSManagerGRpcClient(remoteAddr string) (*EOTSManagerGRpcClient, error)
 	}
 
 	if err := gClient.Ping(); err != nil {
+		conn.Close()
 		return nil, fmt.Errorf("the EOTS manager server is not responding: %w", err)
 	}
 
 	return gClient, nil
 }
 
+// ActiveEndpoint returns the address of the EOTS manager endpoint that
+// the requests are currently sent to
+func (c *EOTSManagerGRpcClient) ActiveEndpoint() string {
+	return c.conn.activeEndpoint()
+}
+
+// tokenCredentials attaches the API token to the authorization header of
+// every request to the EOTS manager
+type tokenCredentials struct {
+	token string
+}
+
+func (tc tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + tc.token}, nil
+}
+
+// RequireTransportSecurity returns false so that the token can also be used
+// for the EOTS manager running on the same host without TLS
+func (tc tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
 func (c *EOTSManagerGRpcClient) Ping() error {
+	return c.PingContext(context.Background())
+}
+
+// PingContext pings the EOTS manager, which gives up once the context is done
+func (c *EOTSManagerGRpcClient) PingContext(ctx context.Context) error {
 	req := &proto.PingRequest{}
 
-	_, err := c.client.Ping(context.Background(), req)
+	_, err := c.client.Ping(ctx, req)
 	if err != nil {
 		return err
 	}
@@ -83,6 +156,9 @@ func (c *EOTSManagerGRpcClient) CreateRandomnessPairList(uid, chainID []byte, st
 	return pubRandFieldValList, nil
 }
 
+// KeyRecord returns the key record including the private key
+// NOTE: it only succeeds if the server is started with --unsafe-allow-key-export,
+// and this should only be used for testing purposes
 func (c *EOTSManagerGRpcClient) KeyRecord(uid []byte, passphrase string) (*types.KeyRecord, error) {
 	req := &proto.KeyRecordRequest{Uid: uid, Passphrase: passphrase}
 
@@ -99,6 +175,121 @@ func (c *EOTSManagerGRpcClient) KeyRecord(uid []byte, passphrase string) (*types
 	}, nil
 }
 
+func (c *EOTSManagerGRpcClient) PubKeyRecord(uid []byte) (*types.PubKeyRecord, error) {
+	req := &proto.PubKeyRecordRequest{Uid: uid}
+
+	res, err := c.client.PubKeyRecord(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	pk, err := schnorr.ParsePubKey(res.Pk)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key in the response: %w", err)
+	}
+
+	return &types.PubKeyRecord{
+		Name:   res.Name,
+		PubKey: pk,
+	}, nil
+}
+
+// ListKeys returns the names and the public keys of the EOTS keys
+func (c *EOTSManagerGRpcClient) ListKeys() ([]*types.PubKeyRecord, error) {
+	res, err := c.client.ListKeys(context.Background(), &proto.ListKeysRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*types.PubKeyRecord, 0, len(res.Keys))
+	for _, k := range res.Keys {
+		pk, err := schnorr.ParsePubKey(k.Pk)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key in the response: %w", err)
+		}
+		records = append(records, &types.PubKeyRecord{
+			Name:   k.Name,
+			PubKey: pk,
+		})
+	}
+
+	return records, nil
+}
+
+// CreateKeyWithMnemonic imports the EOTS key derived from the mnemonic
+func (c *EOTSManagerGRpcClient) CreateKeyWithMnemonic(name, passphrase, hdPath, mnemonic string) (*bbntypes.BIP340PubKey, error) {
+	return c.importKey(&proto.ImportKeyRequest{
+		Name:       name,
+		Passphrase: passphrase,
+		HdPath:     hdPath,
+		Mnemonic:   mnemonic,
+	})
+}
+
+// ImportArmoredKey imports the ASCII armored private key encrypted by armorPassphrase
+func (c *EOTSManagerGRpcClient) ImportArmoredKey(name, armor, armorPassphrase, passphrase string) (*bbntypes.BIP340PubKey, error) {
+	return c.importKey(&proto.ImportKeyRequest{
+		Name:            name,
+		Passphrase:      passphrase,
+		ArmoredPrivKey:  armor,
+		ArmorPassphrase: armorPassphrase,
+	})
+}
+
+func (c *EOTSManagerGRpcClient) importKey(req *proto.ImportKeyRequest) (*bbntypes.BIP340PubKey, error) {
+	res, err := c.client.ImportKey(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	return bbntypes.NewBIP340PubKey(res.Pk)
+}
+
+// DeleteKey deletes the EOTS key, which can never be added back with another name
+func (c *EOTSManagerGRpcClient) DeleteKey(uid []byte, passphrase string) error {
+	req := &proto.DeleteKeyRequest{Uid: uid, Passphrase: passphrase}
+	_, err := c.client.DeleteKey(context.Background(), req)
+
+	return err
+}
+
+// GetInfo returns the version and the status of the EOTS manager
+func (c *EOTSManagerGRpcClient) GetInfo() (*proto.EOTSManagerInfoResponse, error) {
+	return c.client.GetInfo(context.Background(), &proto.EOTSManagerInfoRequest{})
+}
+
+// CheckCompatibility checks that the EOTS manager serves the same API version
+// as the client and returns its info. It fails with ErrIncompatibleEOTSManager
+// if the versions differ, or the EOTS manager is too old to report its version
+func (c *EOTSManagerGRpcClient) CheckCompatibility() (*proto.EOTSManagerInfoResponse, error) {
+	info, err := c.GetInfo()
+	if status.Code(err) == codes.Unimplemented {
+		return nil, fmt.Errorf("%w: the EOTS manager does not report its version, "+
+			"it is older than the client and should be upgraded to API version %d",
+			types.ErrIncompatibleEOTSManager, eotsmanager.APIVersion)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the info of the EOTS manager: %w", err)
+	}
+
+	if info.ApiVersion != eotsmanager.APIVersion {
+		return nil, fmt.Errorf("%w: the EOTS manager %s serves API version %d, while the client requires API version %d",
+			types.ErrIncompatibleEOTSManager, info.Version, info.ApiVersion, eotsmanager.APIVersion)
+	}
+
+	return info, nil
+}
+
+// QueryAuditLog returns the signing audit log entries matching the request
+func (c *EOTSManagerGRpcClient) QueryAuditLog(req *proto.QueryAuditLogRequest) ([]*proto.AuditEntry, error) {
+	res, err := c.client.QueryAuditLog(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Entries, nil
+}
+
 func (c *EOTSManagerGRpcClient) SignEOTS(uid, chaiID, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error) {
 	req := &proto.SignEOTSRequest{
 		Uid:        uid,
@@ -118,6 +309,53 @@ func (c *EOTSManagerGRpcClient) SignEOTS(uid, chaiID, msg []byte, height uint64,
 	return &s, nil
 }
 
+func (c *EOTSManagerGRpcClient) SignEOTSBatch(uid, chainID []byte, msgs []*types.HeightMsg, passphrase string) ([]*btcec.ModNScalar, error) {
+	req := &proto.SignEOTSBatchRequest{
+		Uid:        uid,
+		ChainId:    chainID,
+		Msgs:       make([]*proto.HeightMsg, 0, len(msgs)),
+		Passphrase: passphrase,
+	}
+	for _, m := range msgs {
+		req.Msgs = append(req.Msgs, &proto.HeightMsg{Height: m.Height, Msg: m.Msg})
+	}
+	res, err := c.client.SignEOTSBatch(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Sigs) != len(msgs) {
+		return nil, fmt.Errorf("expected %d signatures, got %d", len(msgs), len(res.Sigs))
+	}
+
+	sigs := make([]*btcec.ModNScalar, 0, len(res.Sigs))
+	for _, sigBytes := range res.Sigs {
+		var s btcec.ModNScalar
+		s.SetByteSlice(sigBytes)
+		sigs = append(sigs, &s)
+	}
+
+	return sigs, nil
+}
+
+func (c *EOTSManagerGRpcClient) UnsafeSignEOTS(uid, chaiID, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error) {
+	req := &proto.SignEOTSRequest{
+		Uid:        uid,
+		ChainId:    chaiID,
+		Msg:        msg,
+		Height:     height,
+		Passphrase: passphrase,
+	}
+	res, err := c.client.UnsafeSignEOTS(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var s btcec.ModNScalar
+	s.SetByteSlice(res.Sig)
+
+	return &s, nil
+}
+
 func (c *EOTSManagerGRpcClient) SignSchnorrSig(uid, msg []byte, passphrase string) (*schnorr.Signature, error) {
 	req := &proto.SignSchnorrSigRequest{Uid: uid, Msg: msg, Passphrase: passphrase}
 	res, err := c.client.SignSchnorrSig(context.Background(), req)
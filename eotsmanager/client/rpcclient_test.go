@@ -0,0 +1,124 @@
+package client_test
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/client"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/proto"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+	"github.com/babylonlabs-io/finality-provider/util"
+)
+
+// infoServer is an EOTS manager server which only serves Ping, CreateKey, and
+// GetInfo with the given API version if it is not nil. GetInfo and CreateKey do
+// not respond until the request is canceled if hang is set
+type infoServer struct {
+	proto.UnimplementedEOTSManagerServer
+
+	apiVersion *uint32
+	hang       bool
+	// numKeys is the number of the keys created
+	numKeys atomic.Int32
+}
+
+func (s *infoServer) Ping(ctx context.Context, req *proto.PingRequest) (*proto.PingResponse, error) {
+	return &proto.PingResponse{}, nil
+}
+
+func (s *infoServer) GetInfo(ctx context.Context, req *proto.EOTSManagerInfoRequest) (*proto.EOTSManagerInfoResponse, error) {
+	if s.hang {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	if s.apiVersion == nil {
+		return s.UnimplementedEOTSManagerServer.GetInfo(ctx, req)
+	}
+
+	return &proto.EOTSManagerInfoResponse{Version: "test", ApiVersion: *s.apiVersion}, nil
+}
+
+func (s *infoServer) CreateKey(ctx context.Context, req *proto.CreateKeyRequest) (*proto.CreateKeyResponse, error) {
+	s.numKeys.Add(1)
+	if s.hang {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	return &proto.CreateKeyResponse{Pk: make([]byte, 32)}, nil
+}
+
+func TestCheckCompatibility(t *testing.T) {
+	compatible := eotsmanager.APIVersion
+	incompatible := eotsmanager.APIVersion + 1
+
+	testCases := []struct {
+		name       string
+		apiVersion *uint32
+		expErr     error
+	}{
+		{"same API version", &compatible, nil},
+		{"different API version", &incompatible, types.ErrIncompatibleEOTSManager},
+		{"GetInfo not supported", nil, types.ErrIncompatibleEOTSManager},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			addr, stop := startInfoServer(t, &infoServer{apiVersion: tc.apiVersion})
+			defer stop()
+
+			em, err := client.NewEOTSManagerGRpcClient(addr, nil, "")
+			require.NoError(t, err)
+			defer em.Close()
+
+			info, err := em.CheckCompatibility()
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, eotsmanager.APIVersion, info.ApiVersion)
+		})
+	}
+}
+
+func TestUnixSocket(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "eotsd.sock")
+	lis, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	apiVersion := eotsmanager.APIVersion
+	stop := serveInfoServer(lis, &infoServer{apiVersion: &apiVersion})
+	defer stop()
+
+	em, err := client.NewEOTSManagerGRpcClient(util.UnixSocketScheme+socketPath, nil, "")
+	require.NoError(t, err)
+	defer em.Close()
+	_, err = em.CheckCompatibility()
+	require.NoError(t, err)
+
+	// the unix socket path should be absolute
+	_, err = client.NewEOTSManagerGRpcClient(util.UnixSocketScheme+"eotsd.sock", nil, "")
+	require.ErrorContains(t, err, "should be absolute")
+}
+
+func startInfoServer(t *testing.T, srv *infoServer) (string, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	return lis.Addr().String(), serveInfoServer(lis, srv)
+}
+
+func serveInfoServer(lis net.Listener, srv *infoServer) func() {
+	grpcServer := grpc.NewServer()
+	proto.RegisterEOTSManagerServer(grpcServer, srv)
+	go func() { _ = grpcServer.Serve(lis) }()
+
+	return grpcServer.Stop
+}
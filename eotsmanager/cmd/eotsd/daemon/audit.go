@@ -0,0 +1,221 @@
+package daemon
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"github.com/urfave/cli"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/proto"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/store"
+)
+
+// AuditEntryOutput is an entry of the signing audit log
+type AuditEntryOutput struct {
+	ID         uint64 `json:"id"`
+	Timestamp  string `json:"timestamp"`
+	Method     string `json:"method"`
+	Caller     string `json:"caller"`
+	PeerAddr   string `json:"peer_addr,omitempty"`
+	PubKeyHex  string `json:"pub_key_hex,omitempty"`
+	ChainID    string `json:"chain_id,omitempty"`
+	Height     uint64 `json:"height,omitempty"`
+	Num        uint32 `json:"num,omitempty"`
+	MsgHashHex string `json:"msg_hash_hex,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+var AuditCommand = cli.Command{
+	Name:     "audit",
+	Usage:    "Query the signing audit log of the EOTS manager.",
+	Category: "Slashing protection",
+	Description: `Print the entries of the signing audit log matching the filters in JSON.
+	Every CreateKey, CreateRandomnessPairList, SignEOTS and SignSchnorrSig request
+	served by the daemon is recorded with its caller and result. The log is read
+	from the home directory unless --rpc-client is set, in which case it is
+	queried from the running daemon.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "Path to the eotsd home directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.StringFlag{
+			Name:  rpcClientFlag,
+			Usage: "The address of a running EOTS manager daemon to query the audit log from, instead of the home directory",
+		},
+		tlsCAFileCliFlag,
+		tlsCertFileCliFlag,
+		tlsKeyFileCliFlag,
+		apiTokenCliFlag,
+		cli.StringFlag{
+			Name:  eotsPkFlag,
+			Usage: "Only show the entries of the given EOTS public key",
+		},
+		cli.Uint64Flag{
+			Name:  fromHeightFlag,
+			Usage: "Only show the entries at or above the given height",
+		},
+		cli.Uint64Flag{
+			Name:  toHeightFlag,
+			Usage: "Only show the entries at or below the given height",
+		},
+		cli.StringFlag{
+			Name:  sinceFlag,
+			Usage: "Only show the entries recorded at or after the given RFC3339 time",
+		},
+		cli.StringFlag{
+			Name:  untilFlag,
+			Usage: "Only show the entries recorded at or before the given RFC3339 time",
+		},
+		cli.UintFlag{
+			Name:  limitFlag,
+			Usage: "The maximum number of entries to show, all the entries are shown if 0",
+		},
+	},
+	Action: queryAuditLog,
+}
+
+func queryAuditLog(ctx *cli.Context) error {
+	req := &proto.QueryAuditLogRequest{
+		FromHeight: ctx.Uint64(fromHeightFlag),
+		ToHeight:   ctx.Uint64(toHeightFlag),
+		Limit:      uint32(ctx.Uint(limitFlag)),
+	}
+	if req.ToHeight != 0 && req.FromHeight > req.ToHeight {
+		return fmt.Errorf("the from height %d should not be above the to height %d", req.FromHeight, req.ToHeight)
+	}
+
+	if fpPkStr := ctx.String(eotsPkFlag); fpPkStr != "" {
+		fpPk, err := bbntypes.NewBIP340PubKeyFromHex(fpPkStr)
+		if err != nil {
+			return fmt.Errorf("invalid EOTS public key %s: %w", fpPkStr, err)
+		}
+		req.EotsPk = fpPk.MustMarshal()
+	}
+
+	since, err := parseTimeFlag(ctx, sinceFlag)
+	if err != nil {
+		return err
+	}
+	until, err := parseTimeFlag(ctx, untilFlag)
+	if err != nil {
+		return err
+	}
+	if !since.IsZero() {
+		req.Since = since.UnixNano()
+	}
+	if !until.IsZero() {
+		req.Until = until.UnixNano()
+	}
+
+	var entries []*proto.AuditEntry
+	if rpcAddr := ctx.String(rpcClientFlag); rpcAddr != "" {
+		em, err := newRPCClient(ctx, rpcAddr)
+		if err != nil {
+			return err
+		}
+		defer em.Close()
+
+		entries, err = em.QueryAuditLog(req)
+		if err != nil {
+			return fmt.Errorf("failed to query the audit log: %w", err)
+		}
+	} else {
+		auditStore, closeDb, err := loadAuditStore(ctx)
+		if err != nil {
+			return err
+		}
+		defer closeDb()
+
+		entries, err = auditStore.QueryEntries(&store.AuditFilter{
+			EOTSPk:     req.EotsPk,
+			FromHeight: req.FromHeight,
+			ToHeight:   req.ToHeight,
+			Since:      since,
+			Until:      until,
+			Limit:      req.Limit,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to query the audit log: %w", err)
+		}
+	}
+
+	outputs := make([]*AuditEntryOutput, 0, len(entries))
+	for _, entry := range entries {
+		outputs = append(outputs, auditEntryToOutput(entry))
+	}
+
+	jsonBytes, err := json.MarshalIndent(outputs, "", "    ")
+	if err != nil {
+		return fmt.Errorf("failed to encode the audit log: %w", err)
+	}
+	fmt.Printf("%s\n", jsonBytes)
+
+	return nil
+}
+
+func parseTimeFlag(ctx *cli.Context, flag string) (time.Time, error) {
+	timeStr := ctx.String(flag)
+	if timeStr == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, timeStr)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --%s time %s, expected RFC3339: %w", flag, timeStr, err)
+	}
+
+	return t, nil
+}
+
+func loadAuditStore(ctx *cli.Context) (*store.AuditStore, func(), error) {
+	homePath, err := getHomeFlag(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load home flag: %w", err)
+	}
+
+	cfg, err := config.LoadConfig(homePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load config at %s: %w", homePath, err)
+	}
+
+	dbBackend, err := cfg.DatabaseConfig.GetDbBackend()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create db backend: %w", err)
+	}
+
+	auditStore, err := store.NewAuditStore(dbBackend)
+	if err != nil {
+		dbBackend.Close()
+		return nil, nil, fmt.Errorf("failed to initialize the audit log: %w", err)
+	}
+
+	return auditStore, func() { dbBackend.Close() }, nil
+}
+
+func auditEntryToOutput(entry *proto.AuditEntry) *AuditEntryOutput {
+	output := &AuditEntryOutput{
+		ID:        entry.Id,
+		Timestamp: time.Unix(0, entry.Timestamp).UTC().Format(time.RFC3339Nano),
+		Method:    entry.Method,
+		Caller:    entry.Caller,
+		PeerAddr:  entry.PeerAddr,
+		ChainID:   string(entry.ChainId),
+		Height:    entry.Height,
+		Num:       entry.Num,
+		Error:     entry.Error,
+	}
+	if len(entry.EotsPk) != 0 {
+		output.PubKeyHex = hex.EncodeToString(entry.EotsPk)
+	}
+	if len(entry.MsgHash) != 0 {
+		output.MsgHashHex = hex.EncodeToString(entry.MsgHash)
+	}
+
+	return output
+}
@@ -0,0 +1,229 @@
+package daemon
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/urfave/cli"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/backup"
+)
+
+var BackupKeyCmd = cli.Command{
+	Name:  "backup",
+	Usage: "Back up a key into Shamir shares encrypted by a passphrase.",
+	Description: fmt.Sprintf(`Split the private key of the key of the %s or %s flag into
+	the number of shares given by the %s flag, any %s of which can restore the key.
+	Each share is encrypted by the backup passphrase and written to its own file in
+	the output directory. The shares should be stored in different places.`,
+		keyNameFlag, eotsPkFlag, sharesFlag, thresholdFlag),
+	Flags: []cli.Flag{
+		homeCliFlag,
+		keyringBackendCliFlag,
+		cli.StringFlag{
+			Name:  keyNameFlag,
+			Usage: "The name of the key to back up",
+		},
+		cli.StringFlag{
+			Name:  eotsPkFlag,
+			Usage: "The EOTS public key of the key to back up",
+		},
+		cli.StringFlag{
+			Name:  passphraseFlag,
+			Usage: "The pass phrase used to decrypt the keyring",
+			Value: defaultPassphrase,
+		},
+		cli.IntFlag{
+			Name:     thresholdFlag,
+			Usage:    "The number of shares needed to restore the key",
+			Required: true,
+		},
+		cli.IntFlag{
+			Name:     sharesFlag,
+			Usage:    "The total number of shares",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:     backupPassphraseFlag,
+			Usage:    "The pass phrase used to encrypt the shares",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:     outputDirFlag,
+			Usage:    "The directory to write the shares to",
+			Required: true,
+		},
+	},
+	Action: backupKey,
+}
+
+var RestoreKeyCmd = cli.Command{
+	Name:      "restore",
+	Usage:     "Restore a key from Shamir shares.",
+	UsageText: "keys restore [share-file] [share-file] ...",
+	Description: fmt.Sprintf(`Decrypt the given shares with the backup passphrase, restore the
+	private key and import it into the keyring. The restored key is verified against
+	the public key recorded in the shares, and against the %s flag if given.`, eotsPkFlag),
+	Flags: []cli.Flag{
+		homeCliFlag,
+		keyringBackendCliFlag,
+		cli.StringFlag{
+			Name:  keyNameFlag,
+			Usage: "The name of the restored key, the name recorded in the shares is used if empty",
+		},
+		cli.StringFlag{
+			Name:  eotsPkFlag,
+			Usage: "The expected EOTS public key of the restored key",
+		},
+		cli.StringFlag{
+			Name:  passphraseFlag,
+			Usage: "The pass phrase used to encrypt the keys",
+			Value: defaultPassphrase,
+		},
+		cli.StringFlag{
+			Name:     backupPassphraseFlag,
+			Usage:    "The pass phrase used to decrypt the shares",
+			Required: true,
+		},
+	},
+	Action: restoreKey,
+}
+
+func backupKey(ctx *cli.Context) error {
+	outputDir := ctx.String(outputDirFlag)
+	threshold := ctx.Int(thresholdFlag)
+	total := ctx.Int(sharesFlag)
+
+	eotsManager, cleanUp, err := loadLocalEOTSManager(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanUp()
+
+	record, err := findKey(ctx, eotsManager)
+	if err != nil {
+		return err
+	}
+
+	keyRecord, err := eotsManager.KeyRecord(schnorr.SerializePubKey(record.PubKey), ctx.String(passphraseFlag))
+	if err != nil {
+		return fmt.Errorf("failed to load the key %s: %w", record.Name, err)
+	}
+
+	shares, err := backup.SplitPrivKey(record.Name, keyRecord.PrivKey, threshold, total, ctx.String(backupPassphraseFlag))
+	if err != nil {
+		return fmt.Errorf("failed to split the key: %w", err)
+	}
+
+	if err := os.MkdirAll(outputDir, 0700); err != nil {
+		return fmt.Errorf("failed to create the output directory %s: %w", outputDir, err)
+	}
+
+	shareFiles := make([]string, 0, len(shares))
+	for _, s := range shares {
+		shareFile := filepath.Join(outputDir, fmt.Sprintf("%s-share-%d-of-%d.json", s.KeyName, s.Index, s.Total))
+		if err := writeShare(shareFile, s); err != nil {
+			return err
+		}
+		shareFiles = append(shareFiles, shareFile)
+	}
+
+	fmt.Printf("Key %s (%s) is backed up into %d shares, any %d of which restore the key:\n",
+		record.Name, shares[0].PubKeyHex, total, threshold)
+	for _, f := range shareFiles {
+		fmt.Println(f)
+	}
+
+	return nil
+}
+
+func restoreKey(ctx *cli.Context) error {
+	shareFiles := ctx.Args()
+	if len(shareFiles) == 0 {
+		return errors.New("invalid argument, please provide the share files as input arguments")
+	}
+
+	shares := make([]*backup.Share, 0, len(shareFiles))
+	for _, shareFile := range shareFiles {
+		s, err := readShare(shareFile)
+		if err != nil {
+			return err
+		}
+		shares = append(shares, s)
+	}
+
+	privKey, err := backup.RecoverPrivKey(shares, ctx.String(backupPassphraseFlag))
+	if err != nil {
+		return fmt.Errorf("failed to restore the key: %w", err)
+	}
+	restoredPk := bbntypes.NewBIP340PubKeyFromBTCPK(privKey.PubKey())
+
+	if fpPkStr := ctx.String(eotsPkFlag); fpPkStr != "" {
+		expectedPk, err := bbntypes.NewBIP340PubKeyFromHex(fpPkStr)
+		if err != nil {
+			return fmt.Errorf("invalid EOTS public key %s: %w", fpPkStr, err)
+		}
+		if !expectedPk.Equals(restoredPk) {
+			return fmt.Errorf("%w: expected %s, got %s", backup.ErrPubKeyMismatch, fpPkStr, restoredPk.MarshalHex())
+		}
+	}
+
+	keyName := ctx.String(keyNameFlag)
+	if keyName == "" {
+		keyName = shares[0].KeyName
+	}
+
+	eotsManager, cleanUp, err := loadLocalEOTSManager(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanUp()
+
+	if _, err := eotsManager.ImportPrivKey(keyName, privKey, ctx.String(passphraseFlag)); err != nil {
+		return fmt.Errorf("failed to import the restored key: %w", err)
+	}
+
+	printRespJSON(KeyOutput{
+		Name:      keyName,
+		PubKeyHex: restoredPk.MarshalHex(),
+	})
+	return nil
+}
+
+func writeShare(shareFile string, s *backup.Share) error {
+	shareBytes, err := json.MarshalIndent(s, "", "    ")
+	if err != nil {
+		return fmt.Errorf("failed to encode share %d: %w", s.Index, err)
+	}
+
+	f, err := os.OpenFile(shareFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create the share file %s: %w", shareFile, err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(shareBytes); err != nil {
+		return fmt.Errorf("failed to write the share file %s: %w", shareFile, err)
+	}
+
+	return nil
+}
+
+func readShare(shareFile string) (*backup.Share, error) {
+	shareBytes, err := os.ReadFile(shareFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the share file %s: %w", shareFile, err)
+	}
+
+	var s backup.Share
+	if err := json.Unmarshal(shareBytes, &s); err != nil {
+		return nil, fmt.Errorf("failed to decode the share file %s: %w", shareFile, err)
+	}
+
+	return &s, nil
+}
@@ -0,0 +1,458 @@
+package daemon
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/babylonlabs-io/babylon/crypto/eots"
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	ftypes "github.com/babylonlabs-io/babylon/x/finality/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/urfave/cli"
+)
+
+// EOTSVerification is the result of verifying an EOTS signature
+type EOTSVerification struct {
+	PubKeyHex    string `json:"pub_key_hex"`
+	PubRandHex   string `json:"pub_rand_hex"`
+	MsgHex       string `json:"msg_hex"`
+	SignatureHex string `json:"signature_hex"`
+	Valid        bool   `json:"valid"`
+	Error        string `json:"error,omitempty"`
+}
+
+// ExtractedKey is the EOTS private key extracted from two signatures
+// made with the same randomness
+type ExtractedKey struct {
+	PubKeyHex  string `json:"pub_key_hex"`
+	PrivKeyHex string `json:"priv_key_hex"`
+}
+
+// eotsSigData is an EOTS signature together with the data it is verified against
+type eotsSigData struct {
+	pubKey  *bbntypes.BIP340PubKey
+	pubRand *bbntypes.SchnorrPubRand
+	msg     []byte
+	sig     *bbntypes.SchnorrEOTSSig
+}
+
+var EOTSCommands = []cli.Command{
+	{
+		Name:     "eots",
+		Usage:    "Command sets of investigating EOTS signatures offline.",
+		Category: "Forensics",
+		Subcommands: []cli.Command{
+			VerifyEOTSSigCmd,
+			ExtractEOTSKeyCmd,
+		},
+	},
+}
+
+var (
+	evidenceCliFlag = cli.StringFlag{
+		Name: evidenceFlag,
+		Usage: "Path to the JSON file of the slashing evidence, i.e., the output of a transaction " +
+			"or block results query including the EventSlashedFinalityProvider event, the event " +
+			"itself, or the evidence",
+	}
+	eotsPkCliFlag = cli.StringFlag{
+		Name:  eotsPkFlag,
+		Usage: "The hex EOTS public key which made the signatures",
+	}
+	pubRandCliFlag = cli.StringFlag{
+		Name:  pubRandFlag,
+		Usage: "The hex public randomness which the signatures are made with",
+	}
+	heightCliFlag = cli.Uint64Flag{
+		Name:  heightFlag,
+		Usage: "The height of the blocks whose app hashes are signed as finality votes",
+	}
+)
+
+var VerifyEOTSSigCmd = cli.Command{
+	Name:  "verify",
+	Usage: "Verify an EOTS signature against a public key, public randomness and message.",
+	Description: `Verify an EOTS signature given through the flags, or both of the finality
+	signatures in the slashing evidence given through --evidence. The message is
+	either given in hex through --msg, or is the finality vote of the block of
+	--height and --app-hash. The command fails if any signature is invalid.`,
+	Flags: []cli.Flag{
+		evidenceCliFlag,
+		eotsPkCliFlag,
+		pubRandCliFlag,
+		cli.StringFlag{
+			Name:  signatureFlag,
+			Usage: "The hex EOTS signature to verify",
+		},
+		cli.StringFlag{
+			Name:  msgFlag,
+			Usage: "The hex message that is signed",
+		},
+		heightCliFlag,
+		cli.StringFlag{
+			Name:  appHashFlag,
+			Usage: "The hex app hash of the block whose finality vote is signed",
+		},
+	},
+	Action: verifyEOTSSig,
+}
+
+var ExtractEOTSKeyCmd = cli.Command{
+	Name:  "extract",
+	Usage: "Extract the EOTS private key from two signatures made with the same randomness.",
+	Description: `Extract the EOTS private key from the two finality signatures in the slashing
+	evidence given through --evidence, or from two signatures over different
+	messages made with the same public randomness given through the flags. The
+	messages are either given in hex through --msg-1 and --msg-2, or are the
+	finality votes of the conflicting blocks of --height and --app-hash-1 and
+	--app-hash-2. Both signatures are verified before extracting the key.`,
+	Flags: []cli.Flag{
+		evidenceCliFlag,
+		eotsPkCliFlag,
+		pubRandCliFlag,
+		cli.StringFlag{
+			Name:  signature1Flag,
+			Usage: "The hex EOTS signature over the first message",
+		},
+		cli.StringFlag{
+			Name:  signature2Flag,
+			Usage: "The hex EOTS signature over the second message",
+		},
+		cli.StringFlag{
+			Name:  msg1Flag,
+			Usage: "The first hex message that is signed",
+		},
+		cli.StringFlag{
+			Name:  msg2Flag,
+			Usage: "The second hex message that is signed",
+		},
+		heightCliFlag,
+		cli.StringFlag{
+			Name:  appHash1Flag,
+			Usage: "The hex app hash of the first block whose finality vote is signed",
+		},
+		cli.StringFlag{
+			Name:  appHash2Flag,
+			Usage: "The hex app hash of the second block whose finality vote is signed",
+		},
+	},
+	Action: extractEOTSKey,
+}
+
+func verifyEOTSSig(ctx *cli.Context) error {
+	var sigs []*eotsSigData
+	if evidencePath := ctx.String(evidenceFlag); evidencePath != "" {
+		evidence, err := loadEvidence(evidencePath)
+		if err != nil {
+			return err
+		}
+		if evidence.CanonicalFinalitySig != nil {
+			sigs = append(sigs, evidenceSigData(evidence, evidence.CanonicalAppHash, evidence.CanonicalFinalitySig))
+		}
+		sigs = append(sigs, evidenceSigData(evidence, evidence.ForkAppHash, evidence.ForkFinalitySig))
+	} else {
+		sig, err := sigDataFromFlags(ctx, signatureFlag, msgFlag, appHashFlag)
+		if err != nil {
+			return err
+		}
+		sigs = append(sigs, sig)
+	}
+
+	results := make([]*EOTSVerification, 0, len(sigs))
+	var numInvalid int
+	for _, sig := range sigs {
+		res := &EOTSVerification{
+			PubKeyHex:    sig.pubKey.MarshalHex(),
+			PubRandHex:   sig.pubRand.ToHexStr(),
+			MsgHex:       hex.EncodeToString(sig.msg),
+			SignatureHex: sig.sig.ToHexStr(),
+			Valid:        true,
+		}
+		if err := sig.verify(); err != nil {
+			res.Valid = false
+			res.Error = err.Error()
+			numInvalid++
+		}
+		results = append(results, res)
+	}
+
+	printRespJSON(results)
+
+	if numInvalid > 0 {
+		return fmt.Errorf("%d of %d EOTS signatures are invalid", numInvalid, len(results))
+	}
+
+	return nil
+}
+
+func extractEOTSKey(ctx *cli.Context) error {
+	if evidencePath := ctx.String(evidenceFlag); evidencePath != "" {
+		evidence, err := loadEvidence(evidencePath)
+		if err != nil {
+			return err
+		}
+		privKey, err := extractPrivKeyFromEvidence(evidence)
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(ExtractedKey{
+			PubKeyHex:  evidence.FpBtcPk.MarshalHex(),
+			PrivKeyHex: hex.EncodeToString(privKey.Serialize()),
+		})
+
+		return nil
+	}
+
+	sig1, err := sigDataFromFlags(ctx, signature1Flag, msg1Flag, appHash1Flag)
+	if err != nil {
+		return err
+	}
+	sig2, err := sigDataFromFlags(ctx, signature2Flag, msg2Flag, appHash2Flag)
+	if err != nil {
+		return err
+	}
+
+	privKey, err := extractPrivKey(sig1, sig2)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(ExtractedKey{
+		PubKeyHex:  sig1.pubKey.MarshalHex(),
+		PrivKeyHex: hex.EncodeToString(privKey.Serialize()),
+	})
+
+	return nil
+}
+
+// extractPrivKeyFromEvidence extracts the private key from the slashing evidence
+// once both of its signatures are verified
+func extractPrivKeyFromEvidence(evidence *ftypes.Evidence) (*btcec.PrivateKey, error) {
+	if !evidence.IsSlashable() {
+		return nil, errors.New("the evidence lacks the canonical finality signature so the key cannot be extracted")
+	}
+	if err := evidenceSigData(evidence, evidence.CanonicalAppHash, evidence.CanonicalFinalitySig).verify(); err != nil {
+		return nil, fmt.Errorf("invalid canonical finality signature: %w", err)
+	}
+	if err := evidenceSigData(evidence, evidence.ForkAppHash, evidence.ForkFinalitySig).verify(); err != nil {
+		return nil, fmt.Errorf("invalid fork finality signature: %w", err)
+	}
+
+	privKey, err := evidence.ExtractBTCSK()
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract the private key: %w", err)
+	}
+
+	return privKey, nil
+}
+
+// extractPrivKey extracts the private key from two valid signatures of the same
+// key and randomness over different messages
+func extractPrivKey(sig1, sig2 *eotsSigData) (*btcec.PrivateKey, error) {
+	if !sig1.pubKey.Equals(sig2.pubKey) {
+		return nil, errors.New("the signatures are made by different public keys")
+	}
+	if !sig1.pubRand.ToFieldVal().Equals(sig2.pubRand.ToFieldVal()) {
+		return nil, errors.New("the signatures are made with different public randomness")
+	}
+	if bytes.Equal(sig1.msg, sig2.msg) {
+		return nil, errors.New("the signatures are over the same message")
+	}
+	if err := sig1.verify(); err != nil {
+		return nil, fmt.Errorf("invalid first signature: %w", err)
+	}
+	if err := sig2.verify(); err != nil {
+		return nil, fmt.Errorf("invalid second signature: %w", err)
+	}
+
+	btcPk, err := sig1.pubKey.ToBTCPK()
+	if err != nil {
+		return nil, fmt.Errorf("invalid EOTS public key: %w", err)
+	}
+
+	privKey, err := eots.Extract(
+		btcPk, sig1.pubRand.ToFieldVal(),
+		sig1.msg, sig1.sig.ToModNScalar(),
+		sig2.msg, sig2.sig.ToModNScalar(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract the private key: %w", err)
+	}
+
+	return privKey, nil
+}
+
+func (d *eotsSigData) verify() error {
+	btcPk, err := d.pubKey.ToBTCPK()
+	if err != nil {
+		return fmt.Errorf("invalid EOTS public key: %w", err)
+	}
+
+	return eots.Verify(btcPk, d.pubRand.ToFieldVal(), d.msg, d.sig.ToModNScalar())
+}
+
+// loadEvidence loads the slashing evidence from the JSON file of either the
+// EventSlashedFinalityProvider event as emitted by Babylon, i.e., with the
+// JSON-encoded evidence attribute, which can be nested in the output of a
+// transaction or block results query, or the typed event, or the evidence itself
+func loadEvidence(evidencePath string) (*ftypes.Evidence, error) {
+	evidenceBytes, err := os.ReadFile(evidencePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the evidence from %s: %w", evidencePath, err)
+	}
+
+	evidence, err := decodeEvidence(evidenceBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the evidence from %s: %w", evidencePath, err)
+	}
+
+	if err := evidence.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid evidence in %s: %w", evidencePath, err)
+	}
+
+	return evidence, nil
+}
+
+func decodeEvidence(evidenceBytes []byte) (*ftypes.Evidence, error) {
+	var doc any
+	if err := json.Unmarshal(evidenceBytes, &doc); err != nil {
+		return nil, err
+	}
+
+	eventType := proto.MessageName(&ftypes.EventSlashedFinalityProvider{})
+	events := findEvents(doc, eventType)
+	switch {
+	case len(events) > 1:
+		return nil, fmt.Errorf("found %d %s events, only one is expected", len(events), eventType)
+	case len(events) == 1:
+		tev, err := sdk.ParseTypedEvent(*events[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the %s event: %w", eventType, err)
+		}
+		event, ok := tev.(*ftypes.EventSlashedFinalityProvider)
+		if !ok || event.Evidence == nil {
+			return nil, fmt.Errorf("the %s event lacks the evidence", eventType)
+		}
+		return event.Evidence, nil
+	}
+
+	var event ftypes.EventSlashedFinalityProvider
+	if err := jsonpb.UnmarshalString(string(evidenceBytes), &event); err == nil && event.Evidence != nil {
+		return event.Evidence, nil
+	}
+
+	var evidence ftypes.Evidence
+	if err := jsonpb.UnmarshalString(string(evidenceBytes), &evidence); err != nil {
+		return nil, err
+	}
+
+	return &evidence, nil
+}
+
+// findEvents returns the CometBFT events of the given type nested anywhere in
+// the JSON document, whose attributes are key and value strings
+func findEvents(doc any, eventType string) []*abci.Event {
+	var events []*abci.Event
+	switch v := doc.(type) {
+	case map[string]any:
+		if t, ok := v["type"].(string); ok && t == eventType {
+			if _, ok := v["attributes"].([]any); ok {
+				bz, err := json.Marshal(v)
+				if err != nil {
+					return nil
+				}
+				var event abci.Event
+				if err := json.Unmarshal(bz, &event); err == nil {
+					return []*abci.Event{&event}
+				}
+			}
+		}
+		for _, field := range v {
+			events = append(events, findEvents(field, eventType)...)
+		}
+	case []any:
+		for _, elem := range v {
+			events = append(events, findEvents(elem, eventType)...)
+		}
+	}
+
+	return events
+}
+
+func evidenceSigData(evidence *ftypes.Evidence, appHash []byte, sig *bbntypes.SchnorrEOTSSig) *eotsSigData {
+	return &eotsSigData{
+		pubKey:  evidence.FpBtcPk,
+		pubRand: evidence.PubRand,
+		msg:     msgToSignForVote(evidence.BlockHeight, appHash),
+		sig:     sig,
+	}
+}
+
+// sigDataFromFlags loads an EOTS signature and the data it is verified against
+// from the flags. The message is either given in hex, or is the finality vote
+// of the block of the height and app hash flags
+func sigDataFromFlags(ctx *cli.Context, sigFlag, msgHexFlag, appHashHexFlag string) (*eotsSigData, error) {
+	pkHex := ctx.String(eotsPkFlag)
+	pubKey, err := bbntypes.NewBIP340PubKeyFromHex(pkHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid EOTS public key %q: %w", pkHex, err)
+	}
+
+	pubRandHex := ctx.String(pubRandFlag)
+	pubRand, err := bbntypes.NewSchnorrPubRandFromHex(pubRandHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public randomness %q: %w", pubRandHex, err)
+	}
+
+	sigHex := ctx.String(sigFlag)
+	sig, err := bbntypes.NewSchnorrEOTSSigFromHex(sigHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid EOTS signature %q of --%s: %w", sigHex, sigFlag, err)
+	}
+
+	var msg []byte
+	switch {
+	case ctx.IsSet(msgHexFlag) && ctx.IsSet(appHashHexFlag):
+		return nil, fmt.Errorf("only one of --%s and --%s should be set", msgHexFlag, appHashHexFlag)
+	case ctx.IsSet(msgHexFlag):
+		msg, err = hex.DecodeString(ctx.String(msgHexFlag))
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex message of --%s: %w", msgHexFlag, err)
+		}
+	case ctx.IsSet(appHashHexFlag):
+		if !ctx.IsSet(heightFlag) {
+			return nil, fmt.Errorf("--%s should be set together with --%s", heightFlag, appHashHexFlag)
+		}
+		appHash, err := hex.DecodeString(ctx.String(appHashHexFlag))
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex app hash of --%s: %w", appHashHexFlag, err)
+		}
+		msg = msgToSignForVote(ctx.Uint64(heightFlag), appHash)
+	default:
+		return nil, fmt.Errorf("either --%s or --%s should be set", msgHexFlag, appHashHexFlag)
+	}
+
+	return &eotsSigData{
+		pubKey:  pubKey,
+		pubRand: pubRand,
+		msg:     msg,
+		sig:     sig,
+	}, nil
+}
+
+// msgToSignForVote returns the message of the finality vote for a block as
+// signed by the finality providers and verified by Babylon
+func msgToSignForVote(blockHeight uint64, appHash []byte) []byte {
+	block := &ftypes.IndexedBlock{Height: blockHeight, AppHash: appHash}
+
+	return block.MsgToSign()
+}
@@ -0,0 +1,175 @@
+package daemon_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/babylonlabs-io/babylon/crypto/eots"
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	ftypes "github.com/babylonlabs-io/babylon/x/finality/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/stretchr/testify/require"
+
+	dcli "github.com/babylonlabs-io/finality-provider/eotsmanager/cmd/eotsd/daemon"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+// FuzzEOTSForensics tests verifying the signatures of a slashing evidence and
+// extracting the private key from it
+func FuzzEOTSForensics(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 5)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		tempDir := t.TempDir()
+		app := testApp()
+
+		// double sign two blocks at the same height with the same randomness
+		sk, pk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		secRand, pubRand, err := eots.RandGen(r)
+		require.NoError(t, err)
+		height := datagen.RandomInt(r, 100000)
+		canonicalAppHash := datagen.GenRandomByteArray(r, 32)
+		forkAppHash := datagen.GenRandomByteArray(r, 32)
+		canonicalMsg := append(sdk.Uint64ToBigEndian(height), canonicalAppHash...)
+		forkMsg := append(sdk.Uint64ToBigEndian(height), forkAppHash...)
+		canonicalSig, err := eots.Sign(sk, secRand, canonicalMsg)
+		require.NoError(t, err)
+		forkSig, err := eots.Sign(sk, secRand, forkMsg)
+		require.NoError(t, err)
+
+		evidence := &ftypes.Evidence{
+			FpBtcPk:              bbntypes.NewBIP340PubKeyFromBTCPK(pk),
+			BlockHeight:          height,
+			PubRand:              bbntypes.NewSchnorrPubRandFromFieldVal(pubRand),
+			CanonicalAppHash:     canonicalAppHash,
+			ForkAppHash:          forkAppHash,
+			CanonicalFinalitySig: bbntypes.NewSchnorrEOTSSigFromModNScalar(canonicalSig),
+			ForkFinalitySig:      bbntypes.NewSchnorrEOTSSigFromModNScalar(forkSig),
+		}
+		m := jsonpb.Marshaler{OrigName: true}
+		evidenceStr, err := m.MarshalToString(evidence)
+		require.NoError(t, err)
+		evidencePath := filepath.Join(tempDir, "evidence.json")
+		err = os.WriteFile(evidencePath, []byte(evidenceStr), 0600)
+		require.NoError(t, err)
+		eventStr, err := m.MarshalToString(&ftypes.EventSlashedFinalityProvider{Evidence: evidence})
+		require.NoError(t, err)
+		eventPath := filepath.Join(tempDir, "event.json")
+		err = os.WriteFile(eventPath, []byte(eventStr), 0600)
+		require.NoError(t, err)
+		// the event as emitted by Babylon, whose evidence attribute is JSON-encoded
+		cmtEvent, err := sdk.TypedEventToEvent(ftypes.NewEventSlashedFinalityProvider(evidence))
+		require.NoError(t, err)
+		cmtEventBytes, err := json.Marshal(map[string][]abci.Event{"events": {abci.Event(cmtEvent)}})
+		require.NoError(t, err)
+		cmtEventPath := filepath.Join(tempDir, "cmt-event.json")
+		err = os.WriteFile(cmtEventPath, cmtEventBytes, 0600)
+		require.NoError(t, err)
+
+		// both signatures of the evidence are valid
+		out := appRunWithOutput(r, t, app, []string{"eotsd", "eots", "verify", "--evidence", evidencePath})
+		var verifications []*dcli.EOTSVerification
+		err = json.Unmarshal([]byte(out), &verifications)
+		require.NoError(t, err)
+		require.Len(t, verifications, 2)
+		for _, v := range verifications {
+			require.True(t, v.Valid)
+		}
+
+		// the private key is extracted from the evidence and the events
+		for _, path := range []string{evidencePath, eventPath, cmtEventPath} {
+			out = appRunWithOutput(r, t, app, []string{"eotsd", "eots", "extract", "--evidence", path})
+			requireExtractedKey(t, evidence.FpBtcPk, out)
+		}
+
+		// the same through the flags
+		keyFlags := []string{
+			fmt.Sprintf("--eots-pk=%s", evidence.FpBtcPk.MarshalHex()),
+			fmt.Sprintf("--pub-rand=%s", evidence.PubRand.ToHexStr()),
+		}
+		out = appRunWithOutput(r, t, app, append([]string{"eotsd", "eots", "verify",
+			fmt.Sprintf("--msg=%s", hex.EncodeToString(canonicalMsg)),
+			fmt.Sprintf("--signature=%s", evidence.CanonicalFinalitySig.ToHexStr()),
+		}, keyFlags...))
+		err = json.Unmarshal([]byte(out), &verifications)
+		require.NoError(t, err)
+		require.Len(t, verifications, 1)
+		require.True(t, verifications[0].Valid)
+
+		out = appRunWithOutput(r, t, app, append([]string{"eotsd", "eots", "extract",
+			fmt.Sprintf("--height=%d", height),
+			fmt.Sprintf("--app-hash-1=%s", hex.EncodeToString(canonicalAppHash)),
+			fmt.Sprintf("--signature-1=%s", evidence.CanonicalFinalitySig.ToHexStr()),
+			fmt.Sprintf("--app-hash-2=%s", hex.EncodeToString(forkAppHash)),
+			fmt.Sprintf("--signature-2=%s", evidence.ForkFinalitySig.ToHexStr()),
+		}, keyFlags...))
+		requireExtractedKey(t, evidence.FpBtcPk, out)
+
+		// a signature over another message is invalid
+		err = app.Run(append([]string{"eotsd", "eots", "verify",
+			fmt.Sprintf("--height=%d", height),
+			fmt.Sprintf("--app-hash=%s", hex.EncodeToString(forkAppHash)),
+			fmt.Sprintf("--signature=%s", evidence.CanonicalFinalitySig.ToHexStr()),
+		}, keyFlags...))
+		require.ErrorContains(t, err, "1 of 1 EOTS signatures are invalid")
+
+		// the key cannot be extracted from a single message
+		err = app.Run(append([]string{"eotsd", "eots", "extract",
+			fmt.Sprintf("--msg-1=%s", hex.EncodeToString(canonicalMsg)),
+			fmt.Sprintf("--signature-1=%s", evidence.CanonicalFinalitySig.ToHexStr()),
+			fmt.Sprintf("--msg-2=%s", hex.EncodeToString(canonicalMsg)),
+			fmt.Sprintf("--signature-2=%s", evidence.CanonicalFinalitySig.ToHexStr()),
+		}, keyFlags...))
+		require.ErrorContains(t, err, "the same message")
+	})
+}
+
+// requireExtractedKey checks the extracted key belongs to the public key. As the
+// public key is x-only, the key is the one of the even Y coordinate, which may
+// be the negation of the key that signed
+func requireExtractedKey(t *testing.T, pk *bbntypes.BIP340PubKey, output string) {
+	var extracted dcli.ExtractedKey
+	err := json.Unmarshal([]byte(output), &extracted)
+	require.NoError(t, err)
+	require.Equal(t, pk.MarshalHex(), extracted.PubKeyHex)
+
+	skBytes, err := hex.DecodeString(extracted.PrivKeyHex)
+	require.NoError(t, err)
+	sk, _ := btcec.PrivKeyFromBytes(skBytes)
+	require.Equal(t, pk.MarshalHex(), bbntypes.NewBIP340PubKeyFromBTCPK(sk.PubKey()).MarshalHex())
+}
+
+// TestEOTSForensicsSlashingTx tests extracting the private key from the output
+// of querying the transaction that slashed a finality provider, in which the
+// evidence is a JSON-encoded attribute of the EventSlashedFinalityProvider event
+func TestEOTSForensicsSlashingTx(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	app := testApp()
+	txPath := filepath.Join("testdata", "slashing_tx.json")
+
+	out := appRunWithOutput(r, t, app, []string{"eotsd", "eots", "verify", "--evidence", txPath})
+	var verifications []*dcli.EOTSVerification
+	err := json.Unmarshal([]byte(out), &verifications)
+	require.NoError(t, err)
+	require.Len(t, verifications, 2)
+	for _, v := range verifications {
+		require.True(t, v.Valid)
+	}
+
+	out = appRunWithOutput(r, t, app, []string{"eotsd", "eots", "extract", "--evidence", txPath})
+	var extracted dcli.ExtractedKey
+	err = json.Unmarshal([]byte(out), &extracted)
+	require.NoError(t, err)
+	require.Equal(t, "b6057f25d95d699796b417a25857ef139ad31bc6cc6622f895f7d85de5938554", extracted.PubKeyHex)
+	require.Equal(t, "4a0acfbc220e374fce55b4254a9d1899e14483166767c572968d74b47653a1da", extracted.PrivKeyHex)
+}
@@ -3,11 +3,13 @@ package daemon
 import "github.com/cosmos/cosmos-sdk/crypto/keyring"
 
 const (
-	homeFlag        = "home"
-	forceFlag       = "force"
-	rpcListenerFlag = "rpc-listener"
-	eotsPkFlag      = "eots-pk"
-	signatureFlag   = "signature"
+	homeFlag            = "home"
+	forceFlag           = "force"
+	rpcListenerFlag     = "rpc-listener"
+	unsafeKeyExportFlag = "unsafe-allow-key-export"
+	unsafeDoubleSign    = "unsafe-allow-double-sign"
+	eotsPkFlag          = "eots-pk"
+	signatureFlag       = "signature"
 
 	// flags for keys
 	keyNameFlag        = "key-name"
@@ -15,8 +17,56 @@ const (
 	hdPathFlag         = "hd-path"
 	keyringBackendFlag = "keyring-backend"
 	recoverFlag        = "recover"
+	rpcClientFlag      = "rpc-client"
+	yesFlag            = "yes"
+
+	// flags for connecting to a running EOTS manager daemon
+	tlsCAFileFlag   = "tls-ca-file"
+	tlsCertFileFlag = "tls-cert-file"
+	tlsKeyFileFlag  = "tls-key-file"
+	apiTokenFlag    = "api-token"
+
+	// flags for backing up keys
+	thresholdFlag        = "threshold"
+	sharesFlag           = "shares"
+	backupPassphraseFlag = "backup-passphrase"
+
+	// flags for importing armored keys
+	armoredKeyFileFlag  = "armored-key-file"
+	armorPassphraseFlag = "armor-passphrase"
+
+	// flags for querying the audit log
+	fromHeightFlag = "from-height"
+	toHeightFlag   = "to-height"
+	sinceFlag      = "since"
+	untilFlag      = "until"
+	limitFlag      = "limit"
+
+	// flags for investigating EOTS signatures
+	evidenceFlag   = "evidence"
+	pubRandFlag    = "pub-rand"
+	msgFlag        = "msg"
+	msg1Flag       = "msg-1"
+	msg2Flag       = "msg-2"
+	signature1Flag = "signature-1"
+	signature2Flag = "signature-2"
+	heightFlag     = "height"
+	appHashFlag    = "app-hash"
+	appHash1Flag   = "app-hash-1"
+	appHash2Flag   = "app-hash-2"
+
+	// flags for TLS certificates
+	outputDirFlag = "output-dir"
+	hostsFlag     = "hosts"
+	validDaysFlag = "valid-days"
 
 	defaultKeyringBackend = keyring.BackendTest
 	defaultHdPath         = ""
 	defaultPassphrase     = ""
+	defaultTLSHosts       = "localhost,127.0.0.1"
+	defaultTLSValidDays   = 365
+
+	// apiTokenEnvVar is the environment variable of the API token, which
+	// keeps the token out of the process list
+	apiTokenEnvVar = "EOTSD_API_TOKEN"
 )
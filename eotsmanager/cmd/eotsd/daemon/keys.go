@@ -2,11 +2,13 @@ package daemon
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/go-bip39"
 	"github.com/urfave/cli"
@@ -14,7 +16,9 @@ import (
 	bbntypes "github.com/babylonlabs-io/babylon/types"
 	"github.com/babylonlabs-io/finality-provider/eotsmanager"
 	"github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
 	"github.com/babylonlabs-io/finality-provider/log"
+	"github.com/babylonlabs-io/finality-provider/util"
 )
 
 type KeyOutput struct {
@@ -30,19 +34,47 @@ var KeysCommands = []cli.Command{
 		Category: "Key management",
 		Subcommands: []cli.Command{
 			AddKeyCmd,
+			ListKeysCmd,
+			ShowKeyCmd,
+			DeleteKeyCmd,
+			ImportKeyCmd,
+			BackupKeyCmd,
+			RestoreKeyCmd,
 		},
 	},
 }
 
+// keysManager manages the EOTS keys either directly in the home directory,
+// or through a running EOTS manager daemon
+type keysManager interface {
+	ListKeys() ([]*types.PubKeyRecord, error)
+	CreateKeyWithMnemonic(name, passphrase, hdPath, mnemonic string) (*bbntypes.BIP340PubKey, error)
+	ImportArmoredKey(name, armor, armorPassphrase, passphrase string) (*bbntypes.BIP340PubKey, error)
+	DeleteKey(uid []byte, passphrase string) error
+}
+
+var (
+	homeCliFlag = cli.StringFlag{
+		Name:  homeFlag,
+		Usage: "Path to the keyring directory",
+		Value: config.DefaultEOTSDir,
+	}
+	keyringBackendCliFlag = cli.StringFlag{
+		Name:  keyringBackendFlag,
+		Usage: "The backend of the keyring",
+		Value: defaultKeyringBackend,
+	}
+	rpcClientCliFlag = cli.StringFlag{
+		Name:  rpcClientFlag,
+		Usage: "The address of a running EOTS manager daemon to manage the keys through, instead of the home directory",
+	}
+)
+
 var AddKeyCmd = cli.Command{
 	Name:  "add",
 	Usage: "Add a key to the EOTS manager keyring.",
 	Flags: []cli.Flag{
-		cli.StringFlag{
-			Name:  homeFlag,
-			Usage: "Path to the keyring directory",
-			Value: config.DefaultEOTSDir,
-		},
+		homeCliFlag,
 		cli.StringFlag{
 			Name:     keyNameFlag,
 			Usage:    "The name of the key to be created",
@@ -58,11 +90,7 @@ var AddKeyCmd = cli.Command{
 			Usage: "The hd path used to derive the private key",
 			Value: defaultHdPath,
 		},
-		cli.StringFlag{
-			Name:  keyringBackendFlag,
-			Usage: "The backend of the keyring",
-			Value: defaultKeyringBackend,
-		},
+		keyringBackendCliFlag,
 		cli.BoolFlag{
 			Name: recoverFlag,
 			Usage: `Will need to provide a seed phrase to recover
@@ -74,33 +102,11 @@ var AddKeyCmd = cli.Command{
 
 func addKey(ctx *cli.Context) error {
 	keyName := ctx.String(keyNameFlag)
-	keyringBackend := ctx.String(keyringBackendFlag)
-
-	homePath, err := getHomeFlag(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to load home flag: %w", err)
-	}
-
-	cfg, err := config.LoadConfig(homePath)
-	if err != nil {
-		return fmt.Errorf("failed to load config at %s: %w", homePath, err)
-	}
-
-	logger, err := log.NewRootLoggerWithFile(config.LogFile(homePath), cfg.LogLevel)
-	if err != nil {
-		return fmt.Errorf("failed to load the logger")
-	}
-
-	dbBackend, err := cfg.DatabaseConfig.GetDbBackend()
-	if err != nil {
-		return fmt.Errorf("failed to create db backend: %w", err)
-	}
-	defer dbBackend.Close()
-
-	eotsManager, err := eotsmanager.NewLocalEOTSManager(homePath, keyringBackend, dbBackend, logger)
+	eotsManager, cleanUp, err := loadLocalEOTSManager(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create EOTS manager: %w", err)
+		return err
 	}
+	defer cleanUp()
 
 	eotsPk, mnemonic, err := createKey(ctx, eotsManager, keyName)
 	if err != nil {
@@ -165,3 +171,340 @@ func printRespJSONKeys(resp interface{}) {
 	fmt.Printf("New key for the BTC chain is created "+
 		"(mnemonic should be kept in a safe place for recovery):\n%s\n", jsonBytes)
 }
+
+var ListKeysCmd = cli.Command{
+	Name:  "list",
+	Usage: "List the keys in the EOTS manager keyring.",
+	Flags: []cli.Flag{
+		homeCliFlag,
+		keyringBackendCliFlag,
+		rpcClientCliFlag,
+		tlsCAFileCliFlag,
+		tlsCertFileCliFlag,
+		tlsKeyFileCliFlag,
+		apiTokenCliFlag,
+	},
+	Action: listKeys,
+}
+
+var ShowKeyCmd = cli.Command{
+	Name:  "show",
+	Usage: "Show a key in the EOTS manager keyring.",
+	Description: fmt.Sprintf(`Show the key of the %s or %s flag.
+	If the both flags are supplied, %s takes priority`, keyNameFlag, eotsPkFlag, eotsPkFlag),
+	Flags: []cli.Flag{
+		homeCliFlag,
+		keyringBackendCliFlag,
+		rpcClientCliFlag,
+		tlsCAFileCliFlag,
+		tlsCertFileCliFlag,
+		tlsKeyFileCliFlag,
+		apiTokenCliFlag,
+		cli.StringFlag{
+			Name:  keyNameFlag,
+			Usage: "The name of the key to show",
+		},
+		cli.StringFlag{
+			Name:  eotsPkFlag,
+			Usage: "The EOTS public key of the key to show",
+		},
+	},
+	Action: showKey,
+}
+
+var DeleteKeyCmd = cli.Command{
+	Name:  "delete",
+	Usage: "Delete a key from the EOTS manager keyring.",
+	Description: fmt.Sprintf(`Delete the key of the %s or %s flag.
+	If the both flags are supplied, %s takes priority.
+	The public key of a deleted key can never be added back with another name,
+	and its signing history is kept.`, keyNameFlag, eotsPkFlag, eotsPkFlag),
+	Flags: []cli.Flag{
+		homeCliFlag,
+		keyringBackendCliFlag,
+		rpcClientCliFlag,
+		tlsCAFileCliFlag,
+		tlsCertFileCliFlag,
+		tlsKeyFileCliFlag,
+		apiTokenCliFlag,
+		cli.StringFlag{
+			Name:  keyNameFlag,
+			Usage: "The name of the key to delete",
+		},
+		cli.StringFlag{
+			Name:  eotsPkFlag,
+			Usage: "The EOTS public key of the key to delete",
+		},
+		cli.StringFlag{
+			Name:  passphraseFlag,
+			Usage: "The pass phrase used to decrypt the keyring",
+			Value: defaultPassphrase,
+		},
+		cli.BoolFlag{
+			Name:  yesFlag,
+			Usage: "Skip the confirmation prompt",
+		},
+	},
+	Action: deleteKey,
+}
+
+var ImportKeyCmd = cli.Command{
+	Name:  "import",
+	Usage: "Import a key from a mnemonic or an armored private key into the EOTS manager keyring.",
+	Description: fmt.Sprintf(`Import the key from the ASCII armored private key file given by the %s flag,
+	or from the mnemonic read from stdin if the flag is not supplied.`, armoredKeyFileFlag),
+	Flags: []cli.Flag{
+		homeCliFlag,
+		keyringBackendCliFlag,
+		rpcClientCliFlag,
+		tlsCAFileCliFlag,
+		tlsCertFileCliFlag,
+		tlsKeyFileCliFlag,
+		apiTokenCliFlag,
+		cli.StringFlag{
+			Name:     keyNameFlag,
+			Usage:    "The name of the key to be imported",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:  passphraseFlag,
+			Usage: "The pass phrase used to encrypt the keys",
+			Value: defaultPassphrase,
+		},
+		cli.StringFlag{
+			Name:  hdPathFlag,
+			Usage: "The hd path used to derive the private key from the mnemonic",
+			Value: defaultHdPath,
+		},
+		cli.StringFlag{
+			Name:  armoredKeyFileFlag,
+			Usage: "The file of the ASCII armored private key to import",
+		},
+		cli.StringFlag{
+			Name:  armorPassphraseFlag,
+			Usage: "The pass phrase used to decrypt the armored private key",
+		},
+	},
+	Action: importKey,
+}
+
+func listKeys(ctx *cli.Context) error {
+	km, cleanUp, err := loadKeysManager(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanUp()
+
+	records, err := km.ListKeys()
+	if err != nil {
+		return fmt.Errorf("failed to list the keys: %w", err)
+	}
+
+	keys := make([]KeyOutput, 0, len(records))
+	for _, record := range records {
+		keys = append(keys, pubKeyRecordToKeyOutput(record))
+	}
+
+	printRespJSON(keys)
+	return nil
+}
+
+func showKey(ctx *cli.Context) error {
+	km, cleanUp, err := loadKeysManager(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanUp()
+
+	record, err := findKey(ctx, km)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(pubKeyRecordToKeyOutput(record))
+	return nil
+}
+
+func deleteKey(ctx *cli.Context) error {
+	km, cleanUp, err := loadKeysManager(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanUp()
+
+	record, err := findKey(ctx, km)
+	if err != nil {
+		return err
+	}
+	keyOut := pubKeyRecordToKeyOutput(record)
+
+	if !ctx.Bool(yesFlag) {
+		confirmed, err := input.GetConfirmation(
+			fmt.Sprintf("Key %s (%s) will be deleted and can never be added back with another name. Continue?",
+				keyOut.Name, keyOut.PubKeyHex),
+			bufio.NewReader(os.Stdin), os.Stderr)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return errors.New("aborted")
+		}
+	}
+
+	if err := km.DeleteKey(schnorr.SerializePubKey(record.PubKey), ctx.String(passphraseFlag)); err != nil {
+		return fmt.Errorf("failed to delete the key: %w", err)
+	}
+
+	fmt.Printf("Key %s (%s) is deleted\n", keyOut.Name, keyOut.PubKeyHex)
+	return nil
+}
+
+func importKey(ctx *cli.Context) error {
+	// the mnemonic or the armored key should never be sent in plaintext
+	if rpcAddr := ctx.String(rpcClientFlag); rpcAddr != "" && !rpcClientTLSEnabled(ctx) {
+		if _, ok := util.UnixSocketPath(rpcAddr); !ok {
+			return fmt.Errorf("importing a key through the EOTS manager at %s requires TLS (--%s) or a unix socket",
+				rpcAddr, tlsCAFileFlag)
+		}
+	}
+
+	keyName := ctx.String(keyNameFlag)
+	passphrase := ctx.String(passphraseFlag)
+
+	var (
+		armor    string
+		mnemonic string
+	)
+	if armoredKeyFile := ctx.String(armoredKeyFileFlag); armoredKeyFile != "" {
+		armorBytes, err := os.ReadFile(armoredKeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to read the armored private key from %s: %w", armoredKeyFile, err)
+		}
+		armor = string(armorBytes)
+	} else {
+		reader := bufio.NewReader(os.Stdin)
+		m, err := input.GetString("Enter your mnemonic", reader)
+		if err != nil {
+			return fmt.Errorf("failed to read mnemonic from stdin: %w", err)
+		}
+		if !bip39.IsMnemonicValid(m) {
+			return errors.New("invalid mnemonic")
+		}
+		mnemonic = m
+	}
+
+	km, cleanUp, err := loadKeysManager(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanUp()
+
+	var eotsPk *bbntypes.BIP340PubKey
+	if armor != "" {
+		eotsPk, err = km.ImportArmoredKey(keyName, armor, ctx.String(armorPassphraseFlag), passphrase)
+	} else {
+		eotsPk, err = km.CreateKeyWithMnemonic(keyName, passphrase, ctx.String(hdPathFlag), mnemonic)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to import the key: %w", err)
+	}
+
+	printRespJSON(KeyOutput{
+		Name:      keyName,
+		PubKeyHex: eotsPk.MarshalHex(),
+	})
+	return nil
+}
+
+// findKey finds the key of the EOTS public key flag, or the key name flag
+// if the public key is not given
+func findKey(ctx *cli.Context, km keysManager) (*types.PubKeyRecord, error) {
+	keyName := ctx.String(keyNameFlag)
+	fpPkStr := ctx.String(eotsPkFlag)
+	if len(fpPkStr) == 0 && len(keyName) == 0 {
+		return nil, fmt.Errorf("at least one of the flags: %s, %s needs to be informed", keyNameFlag, eotsPkFlag)
+	}
+
+	var fpPk *bbntypes.BIP340PubKey
+	if len(fpPkStr) > 0 {
+		pk, err := bbntypes.NewBIP340PubKeyFromHex(fpPkStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EOTS public key %s: %w", fpPkStr, err)
+		}
+		fpPk = pk
+	}
+
+	records, err := km.ListKeys()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the keys: %w", err)
+	}
+
+	for _, record := range records {
+		if fpPk != nil {
+			if bytes.Equal(*fpPk, schnorr.SerializePubKey(record.PubKey)) {
+				return record, nil
+			}
+			continue
+		}
+		if record.Name == keyName {
+			return record, nil
+		}
+	}
+
+	if fpPk != nil {
+		return nil, fmt.Errorf("key with EOTS public key %s not found", fpPkStr)
+	}
+	return nil, fmt.Errorf("key %s not found", keyName)
+}
+
+func pubKeyRecordToKeyOutput(record *types.PubKeyRecord) KeyOutput {
+	return KeyOutput{
+		Name:      record.Name,
+		PubKeyHex: bbntypes.NewBIP340PubKeyFromBTCPK(record.PubKey).MarshalHex(),
+	}
+}
+
+// loadKeysManager connects to the EOTS manager daemon if the rpc client flag
+// is set, or loads the keys from the home directory otherwise
+func loadKeysManager(ctx *cli.Context) (keysManager, func(), error) {
+	if rpcAddr := ctx.String(rpcClientFlag); rpcAddr != "" {
+		em, err := newRPCClient(ctx, rpcAddr)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return em, func() { em.Close() }, nil
+	}
+
+	return loadLocalEOTSManager(ctx)
+}
+
+func loadLocalEOTSManager(ctx *cli.Context) (*eotsmanager.LocalEOTSManager, func(), error) {
+	homePath, err := getHomeFlag(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load home flag: %w", err)
+	}
+
+	cfg, err := config.LoadConfig(homePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load config at %s: %w", homePath, err)
+	}
+
+	logger, err := log.NewRootLoggerWithFile(config.LogFile(homePath), cfg.LogLevel)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load the logger")
+	}
+
+	dbBackend, err := cfg.DatabaseConfig.GetDbBackend()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create db backend: %w", err)
+	}
+
+	eotsManager, err := eotsmanager.NewLocalEOTSManager(homePath, ctx.String(keyringBackendFlag), dbBackend, logger)
+	if err != nil {
+		dbBackend.Close()
+		return nil, nil, fmt.Errorf("failed to create EOTS manager: %w", err)
+	}
+
+	return eotsManager, func() { dbBackend.Close() }, nil
+}
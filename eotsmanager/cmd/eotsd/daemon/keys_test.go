@@ -0,0 +1,219 @@
+package daemon_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/babylonlabs-io/finality-provider/codec"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager"
+	dcli "github.com/babylonlabs-io/finality-provider/eotsmanager/cmd/eotsd/daemon"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+func FuzzKeysManagement(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 5)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		tempDir := t.TempDir()
+		homeDir := filepath.Join(tempDir, "eots-home")
+		app := testApp()
+
+		hFlag := fmt.Sprintf("--home=%s", homeDir)
+		err := app.Run([]string{"eotsd", "init", hFlag})
+		require.NoError(t, err)
+
+		// add a key
+		keyName := testutil.GenRandomHexStr(r, 10)
+		outputKeysAdd := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "add", hFlag, "--key-name=" + keyName})
+		var keyOut dcli.KeyOutput
+		err = json.Unmarshal([]byte(searchInTxt(outputKeysAdd, "for recovery):")), &keyOut)
+		require.NoError(t, err)
+
+		// import a key from an armored private key
+		armoredKeyName := testutil.GenRandomHexStr(r, 11)
+		armorPassphrase := testutil.GenRandomHexStr(r, 8)
+		armoredKeyFile := filepath.Join(tempDir, "key.armor")
+		armoredPkHex := writeArmoredKeyToFile(t, armoredKeyFile, armorPassphrase)
+		outputImport := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "import", hFlag,
+			"--key-name=" + armoredKeyName, "--armored-key-file=" + armoredKeyFile, "--armor-passphrase=" + armorPassphrase})
+		var importedOut dcli.KeyOutput
+		err = json.Unmarshal([]byte(jsonFromOutput(outputImport)), &importedOut)
+		require.NoError(t, err)
+		require.Equal(t, armoredKeyName, importedOut.Name)
+		require.Equal(t, armoredPkHex, importedOut.PubKeyHex)
+
+		// list the keys
+		outputList := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "list", hFlag})
+		var listOut []dcli.KeyOutput
+		err = json.Unmarshal([]byte(jsonFromOutput(outputList)), &listOut)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []dcli.KeyOutput{
+			{Name: keyName, PubKeyHex: keyOut.PubKeyHex},
+			importedOut,
+		}, listOut)
+
+		// show the key by name and by public key
+		for _, flag := range []string{"--key-name=" + keyName, "--eots-pk=" + keyOut.PubKeyHex} {
+			outputShow := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "show", hFlag, flag})
+			var showOut dcli.KeyOutput
+			err = json.Unmarshal([]byte(jsonFromOutput(outputShow)), &showOut)
+			require.NoError(t, err)
+			require.Equal(t, keyName, showOut.Name)
+			require.Equal(t, keyOut.PubKeyHex, showOut.PubKeyHex)
+		}
+
+		// delete the key
+		err = app.Run([]string{"eotsd", "keys", "delete", hFlag, "--key-name=" + keyName, "--yes"})
+		require.NoError(t, err)
+		err = app.Run([]string{"eotsd", "keys", "show", hFlag, "--key-name=" + keyName})
+		require.Error(t, err)
+
+		// the deleted key cannot be imported with another name
+		mnemonicFile := filepath.Join(tempDir, "mnemonic")
+		err = os.WriteFile(mnemonicFile, []byte(keyOut.Mnemonic+"\n"), 0600)
+		require.NoError(t, err)
+		err = appRunWithStdin(t, app, mnemonicFile, []string{"eotsd", "keys", "import", hFlag, "--key-name=" + keyName + "-new"})
+		require.Error(t, err)
+
+		// but it can be imported with its previous name
+		err = appRunWithStdin(t, app, mnemonicFile, []string{"eotsd", "keys", "import", hFlag, "--key-name=" + keyName})
+		require.NoError(t, err)
+		outputShow := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "show", hFlag, "--eots-pk=" + keyOut.PubKeyHex})
+		var showOut dcli.KeyOutput
+		err = json.Unmarshal([]byte(jsonFromOutput(outputShow)), &showOut)
+		require.NoError(t, err)
+		require.Equal(t, keyName, showOut.Name)
+	})
+}
+
+// jsonFromOutput skips the log lines before the JSON output
+func jsonFromOutput(text string) string {
+	for _, prefix := range []string{"{", "["} {
+		if strings.HasPrefix(text, prefix) {
+			return text
+		}
+		if idx := strings.Index(text, "\n"+prefix); idx >= 0 {
+			return text[idx+1:]
+		}
+	}
+	return text
+}
+
+func appRunWithStdin(t *testing.T, app *cli.App, stdinPath string, arguments []string) error {
+	stdinFile, err := os.Open(stdinPath)
+	require.NoError(t, err)
+	defer stdinFile.Close()
+
+	oldStdin := os.Stdin
+	os.Stdin = stdinFile
+	defer func() { os.Stdin = oldStdin }()
+
+	return app.Run(arguments)
+}
+
+// writeArmoredKeyToFile writes a new armored private key to the file and
+// returns the hex of its EOTS public key
+func writeArmoredKeyToFile(t *testing.T, armoredKeyFile, armorPassphrase string) string {
+	kr := keyring.NewInMemory(codec.MakeCodec())
+	mnemonic, err := eotsmanager.NewMnemonic()
+	require.NoError(t, err)
+	record, err := kr.NewAccount("armored", mnemonic, "", "", hd.Secp256k1)
+	require.NoError(t, err)
+	armor, err := kr.ExportPrivKeyArmor("armored", armorPassphrase)
+	require.NoError(t, err)
+	err = os.WriteFile(armoredKeyFile, []byte(armor), 0600)
+	require.NoError(t, err)
+
+	pk, err := record.GetPubKey()
+	require.NoError(t, err)
+	// the BIP340 public key is the compressed public key without the prefix
+	return fmt.Sprintf("%x", pk.Bytes()[1:])
+}
+
+func FuzzBackupAndRestoreKey(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 3)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		tempDir := t.TempDir()
+		homeDir := filepath.Join(tempDir, "eots-home")
+		newHomeDir := filepath.Join(tempDir, "new-eots-home")
+		app := testApp()
+
+		hFlag := fmt.Sprintf("--home=%s", homeDir)
+		err := app.Run([]string{"eotsd", "init", hFlag})
+		require.NoError(t, err)
+		newHFlag := fmt.Sprintf("--home=%s", newHomeDir)
+		err = app.Run([]string{"eotsd", "init", newHFlag})
+		require.NoError(t, err)
+
+		keyName := testutil.GenRandomHexStr(r, 10)
+		outputKeysAdd := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "add", hFlag, "--key-name=" + keyName})
+		var keyOut dcli.KeyOutput
+		err = json.Unmarshal([]byte(searchInTxt(outputKeysAdd, "for recovery):")), &keyOut)
+		require.NoError(t, err)
+
+		sharesDir := filepath.Join(tempDir, "shares")
+		backupPassphraseFlag := "--backup-passphrase=" + testutil.GenRandomHexStr(r, 8)
+		err = app.Run([]string{"eotsd", "keys", "backup", hFlag, "--key-name=" + keyName,
+			"--threshold=2", "--shares=3", "--output-dir=" + sharesDir, backupPassphraseFlag})
+		require.NoError(t, err)
+
+		shareFiles, err := filepath.Glob(filepath.Join(sharesDir, "*.json"))
+		require.NoError(t, err)
+		require.Len(t, shareFiles, 3)
+
+		// a single share cannot restore the key
+		err = app.Run([]string{"eotsd", "keys", "restore", shareFiles[0], newHFlag, backupPassphraseFlag})
+		require.Error(t, err)
+
+		// the restored key should match the expected public key
+		_, otherPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		err = app.Run([]string{"eotsd", "keys", "restore", shareFiles[0], shareFiles[2], newHFlag, backupPassphraseFlag,
+			"--eots-pk=" + bbntypes.NewBIP340PubKeyFromBTCPK(otherPk).MarshalHex()})
+		require.Error(t, err)
+
+		perm := r.Perm(3)
+		outputRestore := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "restore",
+			shareFiles[perm[0]], shareFiles[perm[1]], newHFlag, backupPassphraseFlag, "--eots-pk=" + keyOut.PubKeyHex})
+		var restoredOut dcli.KeyOutput
+		err = json.Unmarshal([]byte(jsonFromOutput(outputRestore)), &restoredOut)
+		require.NoError(t, err)
+		require.Equal(t, keyName, restoredOut.Name)
+		require.Equal(t, keyOut.PubKeyHex, restoredOut.PubKeyHex)
+
+		// the restored key signs the same as the original key
+		dataFile := filepath.Join(tempDir, "data")
+		err = os.WriteFile(dataFile, datagen.GenRandomByteArray(r, 32), 0600)
+		require.NoError(t, err)
+		signed := appRunSignSchnorr(r, t, app, []string{dataFile, hFlag, "--key-name=" + keyName})
+		restoredSigned := appRunSignSchnorr(r, t, app, []string{dataFile, newHFlag, "--key-name=" + keyName})
+		require.Equal(t, signed.SchnorrSignatureHex, restoredSigned.SchnorrSignatureHex)
+	})
+}
+
+func TestImportKeyRefusesPlaintextRPC(t *testing.T) {
+	app := testApp()
+	armoredKeyFile := filepath.Join(t.TempDir(), "key.armor")
+	writeArmoredKeyToFile(t, armoredKeyFile, "passphrase")
+
+	// the armored key would be sent to the daemon without TLS
+	err := app.Run([]string{"eotsd", "keys", "import", "--key-name=key",
+		"--armored-key-file=" + armoredKeyFile, "--armor-passphrase=passphrase",
+		"--rpc-client=127.0.0.1:12582"})
+	require.ErrorContains(t, err, "requires TLS")
+}
@@ -0,0 +1,70 @@
+package daemon
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/client"
+	"github.com/babylonlabs-io/finality-provider/util"
+)
+
+// the flags of the connection to a running EOTS manager daemon, which mirror
+// the EOTS manager TLS config and API token of fpd
+var (
+	tlsCAFileCliFlag = cli.StringFlag{
+		Name:  tlsCAFileFlag,
+		Usage: "Path to the CA certificate to verify the EOTS manager daemon; TLS is disabled if empty",
+	}
+	tlsCertFileCliFlag = cli.StringFlag{
+		Name:  tlsCertFileFlag,
+		Usage: "Path to the TLS client certificate presented to the EOTS manager daemon",
+	}
+	tlsKeyFileCliFlag = cli.StringFlag{
+		Name:  tlsKeyFileFlag,
+		Usage: "Path to the TLS client private key",
+	}
+	apiTokenCliFlag = cli.StringFlag{
+		Name:   apiTokenFlag,
+		Usage:  "The API token identifying this client if the EOTS manager daemon enforces a signing authorization policy",
+		EnvVar: apiTokenEnvVar,
+	}
+)
+
+// rpcClientTLSEnabled returns whether the connection to the EOTS manager
+// daemon is secured by TLS
+func rpcClientTLSEnabled(ctx *cli.Context) bool {
+	return ctx.String(tlsCAFileFlag) != ""
+}
+
+// newRPCClient connects to the EOTS manager daemon at the given address with
+// the TLS and API token flags
+func newRPCClient(ctx *cli.Context, rpcAddr string) (*client.EOTSManagerGRpcClient, error) {
+	caFile := ctx.String(tlsCAFileFlag)
+	certFile := ctx.String(tlsCertFileFlag)
+	keyFile := ctx.String(tlsKeyFileFlag)
+
+	if (certFile == "") != (keyFile == "") {
+		return nil, fmt.Errorf("the --%s and --%s flags should be specified together", tlsCertFileFlag, tlsKeyFileFlag)
+	}
+	if certFile != "" && caFile == "" {
+		return nil, fmt.Errorf("the TLS client certificate requires the --%s flag to verify the EOTS manager", tlsCAFileFlag)
+	}
+
+	var tlsCfg *tls.Config
+	if caFile != "" {
+		cfg, err := util.LoadClientTLSConfig(caFile, certFile, keyFile, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the TLS config: %w", err)
+		}
+		tlsCfg = cfg
+	}
+
+	em, err := client.NewEOTSManagerGRpcClient(rpcAddr, tlsCfg, ctx.String(apiTokenFlag))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the EOTS manager at %s: %w", rpcAddr, err)
+	}
+
+	return em, nil
+}
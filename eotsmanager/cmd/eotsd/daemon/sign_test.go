@@ -149,7 +149,9 @@ func writeFpInfoToFile(r *rand.Rand, t *testing.T, fpInfoPath, btcPk string) {
 func testApp() *cli.App {
 	app := cli.NewApp()
 	app.Name = "eotsd"
-	app.Commands = append(app.Commands, dcli.StartCommand, dcli.InitCommand, dcli.SignSchnorrSig, dcli.VerifySchnorrSig, dcli.ExportPoPCommand)
+	app.Commands = append(app.Commands, dcli.StartCommand, dcli.InitCommand, dcli.SignSchnorrSig, dcli.VerifySchnorrSig, dcli.ExportPoPCommand, dcli.GenTLSCertsCommand, dcli.AuditCommand)
 	app.Commands = append(app.Commands, dcli.KeysCommands...)
+	app.Commands = append(app.Commands, dcli.SigningHistoryCommands...)
+	app.Commands = append(app.Commands, dcli.EOTSCommands...)
 	return app
 }
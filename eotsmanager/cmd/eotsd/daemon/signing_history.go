@@ -0,0 +1,255 @@
+package daemon
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"github.com/urfave/cli"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/store"
+)
+
+// SigningHistoryFormatVersion is the version of the signing history interchange format
+const SigningHistoryFormatVersion = "1"
+
+// SigningHistory is the interchange format of the signing history of EOTS keys.
+// It is modelled after the slashing protection interchange format of EIP-3076
+// so that the history can be moved together with the keys across hosts.
+type SigningHistory struct {
+	Metadata SigningHistoryMetadata `json:"metadata"`
+	Data     []*KeySigningHistory   `json:"data"`
+}
+
+type SigningHistoryMetadata struct {
+	InterchangeFormatVersion string `json:"interchange_format_version"`
+}
+
+// KeySigningHistory holds the signed records of a single EOTS key
+type KeySigningHistory struct {
+	PubKeyHex     string          `json:"pub_key_hex"`
+	SignedRecords []*SignedRecord `json:"signed_records"`
+}
+
+// SignedRecord is a message signed by an EOTS key at a chain and height
+type SignedRecord struct {
+	ChainID    string `json:"chain_id"`
+	Height     uint64 `json:"height,string"`
+	MsgHashHex string `json:"msg_hash_hex"`
+}
+
+var SigningHistoryCommands = []cli.Command{
+	{
+		Name:     "signing-history",
+		Usage:    "Command sets of exporting and importing the signing history of EOTS keys.",
+		Category: "Slashing protection",
+		Subcommands: []cli.Command{
+			ExportSigningHistoryCmd,
+			ImportSigningHistoryCmd,
+		},
+	},
+}
+
+var ExportSigningHistoryCmd = cli.Command{
+	Name:      "export",
+	Usage:     "Export the signing history of the EOTS keys in the interchange format.",
+	UsageText: "signing-history export [file-path]",
+	Description: `Export the signing history stored in the EOTS manager database to the
+	given file, or to stdout if no file is given. The EOTS manager daemon should
+	be stopped before exporting so that the history is complete.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "Path to the eotsd home directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.StringFlag{
+			Name:  eotsPkFlag,
+			Usage: "Only export the signing history of the given EOTS public key",
+		},
+	},
+	Action: exportSigningHistory,
+}
+
+var ImportSigningHistoryCmd = cli.Command{
+	Name:      "import",
+	Usage:     "Import the signing history of the EOTS keys in the interchange format.",
+	UsageText: "signing-history import [file-path]",
+	Description: `Merge the signing history from the given file into the EOTS manager
+	database. The import is refused as a whole if any record conflicts with
+	a record already in the database, i.e., a different message has been signed
+	at the same chain and height. The EOTS manager daemon should be stopped
+	before importing.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "Path to the eotsd home directory",
+			Value: config.DefaultEOTSDir,
+		},
+	},
+	Action: importSigningHistory,
+}
+
+func exportSigningHistory(ctx *cli.Context) error {
+	var pk []byte
+	if fpPkStr := ctx.String(eotsPkFlag); fpPkStr != "" {
+		fpPk, err := bbntypes.NewBIP340PubKeyFromHex(fpPkStr)
+		if err != nil {
+			return fmt.Errorf("invalid EOTS public key %s: %w", fpPkStr, err)
+		}
+		pk = fpPk.MustMarshal()
+	}
+
+	es, closeDb, err := loadEOTSStore(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeDb()
+
+	records, err := es.GetAllSignRecords(pk)
+	if err != nil {
+		return fmt.Errorf("failed to get the signing records: %w", err)
+	}
+
+	jsonBytes, err := json.MarshalIndent(signRecordsToSigningHistory(records), "", "    ")
+	if err != nil {
+		return fmt.Errorf("failed to encode the signing history: %w", err)
+	}
+
+	outputFilePath := ctx.Args().First()
+	if outputFilePath == "" {
+		fmt.Printf("%s\n", jsonBytes)
+		return nil
+	}
+
+	if err := os.WriteFile(outputFilePath, jsonBytes, 0600); err != nil {
+		return fmt.Errorf("failed to write the signing history to %s: %w", outputFilePath, err)
+	}
+
+	fmt.Printf("Exported %d signing records to %s\n", len(records), outputFilePath)
+	return nil
+}
+
+func importSigningHistory(ctx *cli.Context) error {
+	inputFilePath := ctx.Args().First()
+	if inputFilePath == "" {
+		return errors.New("invalid argument, please provide a valid file path as input argument")
+	}
+
+	jsonBytes, err := os.ReadFile(inputFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to read the signing history from %s: %w", inputFilePath, err)
+	}
+
+	var history SigningHistory
+	if err := json.Unmarshal(jsonBytes, &history); err != nil {
+		return fmt.Errorf("failed to decode the signing history: %w", err)
+	}
+
+	records, err := signingHistoryToSignRecords(&history)
+	if err != nil {
+		return fmt.Errorf("invalid signing history: %w", err)
+	}
+
+	es, closeDb, err := loadEOTSStore(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeDb()
+
+	if err := es.SaveSignRecords(records); err != nil {
+		return fmt.Errorf("failed to import the signing history: %w", err)
+	}
+
+	fmt.Printf("Imported %d signing records from %s\n", len(records), inputFilePath)
+	return nil
+}
+
+func loadEOTSStore(ctx *cli.Context) (*store.EOTSStore, func(), error) {
+	homePath, err := getHomeFlag(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load home flag: %w", err)
+	}
+
+	cfg, err := config.LoadConfig(homePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load config at %s: %w", homePath, err)
+	}
+
+	dbBackend, err := cfg.DatabaseConfig.GetDbBackend()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create db backend: %w", err)
+	}
+
+	es, err := store.NewEOTSStore(dbBackend)
+	if err != nil {
+		dbBackend.Close()
+		return nil, nil, fmt.Errorf("failed to initialize store: %w", err)
+	}
+
+	return es, func() { dbBackend.Close() }, nil
+}
+
+func signRecordsToSigningHistory(records []*store.SignRecord) *SigningHistory {
+	history := &SigningHistory{
+		Metadata: SigningHistoryMetadata{InterchangeFormatVersion: SigningHistoryFormatVersion},
+		Data:     []*KeySigningHistory{},
+	}
+
+	// records are sorted by public key so the ones of the same key are adjacent
+	var current *KeySigningHistory
+	for _, r := range records {
+		pkHex := hex.EncodeToString(r.PubKey)
+		if current == nil || current.PubKeyHex != pkHex {
+			current = &KeySigningHistory{PubKeyHex: pkHex}
+			history.Data = append(history.Data, current)
+		}
+		current.SignedRecords = append(current.SignedRecords, &SignedRecord{
+			ChainID:    string(r.ChainID),
+			Height:     r.Height,
+			MsgHashHex: hex.EncodeToString(r.MsgHash),
+		})
+	}
+
+	return history
+}
+
+func signingHistoryToSignRecords(history *SigningHistory) ([]*store.SignRecord, error) {
+	if history.Metadata.InterchangeFormatVersion != SigningHistoryFormatVersion {
+		return nil, fmt.Errorf("unsupported interchange format version %q, expected %q",
+			history.Metadata.InterchangeFormatVersion, SigningHistoryFormatVersion)
+	}
+
+	var records []*store.SignRecord
+	for _, keyHistory := range history.Data {
+		pk, err := bbntypes.NewBIP340PubKeyFromHex(keyHistory.PubKeyHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EOTS public key %s: %w", keyHistory.PubKeyHex, err)
+		}
+
+		for _, sr := range keyHistory.SignedRecords {
+			if sr.ChainID == "" {
+				return nil, fmt.Errorf("empty chain id in the signing record of %s at height %d",
+					keyHistory.PubKeyHex, sr.Height)
+			}
+			msgHash, err := hex.DecodeString(sr.MsgHashHex)
+			if err != nil || len(msgHash) != 32 {
+				return nil, fmt.Errorf("invalid message hash %s in the signing record of %s at height %d",
+					sr.MsgHashHex, keyHistory.PubKeyHex, sr.Height)
+			}
+
+			records = append(records, &store.SignRecord{
+				PubKey:  pk.MustMarshal(),
+				ChainID: []byte(sr.ChainID),
+				Height:  sr.Height,
+				MsgHash: msgHash,
+			})
+		}
+	}
+
+	return records, nil
+}
@@ -0,0 +1,107 @@
+package daemon_test
+
+import (
+	"fmt"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/store"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+func FuzzExportImportSigningHistory(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 5)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		tempDir := t.TempDir()
+		srcHomeDir := filepath.Join(tempDir, "eots-home-src")
+		dstHomeDir := filepath.Join(tempDir, "eots-home-dst")
+		app := testApp()
+
+		err := app.Run([]string{"eotsd", "init", fmt.Sprintf("--home=%s", srcHomeDir)})
+		require.NoError(t, err)
+		err = app.Run([]string{"eotsd", "init", fmt.Sprintf("--home=%s", dstHomeDir)})
+		require.NoError(t, err)
+
+		// sign records on the source host
+		_, btcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		pk := schnorr.SerializePubKey(btcPk)
+		chainID := []byte(testutil.GenRandomHexStr(r, 10))
+		startHeight := datagen.RandomInt(r, 1000)
+		numRecords := r.Intn(10) + 1
+		records := make([]*store.SignRecord, 0, numRecords)
+		for i := 0; i < numRecords; i++ {
+			records = append(records, &store.SignRecord{
+				PubKey:  pk,
+				ChainID: chainID,
+				Height:  startHeight + uint64(i),
+				MsgHash: datagen.GenRandomByteArray(r, 32),
+			})
+		}
+		withEOTSStore(t, srcHomeDir, func(es *store.EOTSStore) {
+			err := es.SaveSignRecords(records)
+			require.NoError(t, err)
+		})
+
+		// export from the source host and import to the destination host
+		historyPath := filepath.Join(tempDir, "history.json")
+		err = app.Run([]string{"eotsd", "signing-history", "export", historyPath, fmt.Sprintf("--home=%s", srcHomeDir)})
+		require.NoError(t, err)
+		err = app.Run([]string{"eotsd", "signing-history", "import", historyPath, fmt.Sprintf("--home=%s", dstHomeDir)})
+		require.NoError(t, err)
+
+		withEOTSStore(t, dstHomeDir, func(es *store.EOTSStore) {
+			imported, err := es.GetAllSignRecords(pk)
+			require.NoError(t, err)
+			require.Equal(t, records, imported)
+
+			// sign a conflicting message on the destination host
+			err = es.SaveSignRecord(pk, chainID, startHeight, datagen.GenRandomByteArray(r, 32))
+			require.ErrorIs(t, err, store.ErrConflictingSignRecord)
+		})
+
+		// importing the same history again is a no-op
+		err = app.Run([]string{"eotsd", "signing-history", "import", historyPath, fmt.Sprintf("--home=%s", dstHomeDir)})
+		require.NoError(t, err)
+
+		// importing a conflicting history is refused as a whole
+		conflicting := &store.SignRecord{
+			PubKey:  pk,
+			ChainID: chainID,
+			Height:  startHeight + uint64(numRecords),
+			MsgHash: datagen.GenRandomByteArray(r, 32),
+		}
+		withEOTSStore(t, srcHomeDir, func(es *store.EOTSStore) {
+			err := es.SaveSignRecords([]*store.SignRecord{conflicting})
+			require.NoError(t, err)
+		})
+		withEOTSStore(t, dstHomeDir, func(es *store.EOTSStore) {
+			err := es.SaveSignRecord(pk, chainID, conflicting.Height, datagen.GenRandomByteArray(r, 32))
+			require.NoError(t, err)
+		})
+		err = app.Run([]string{"eotsd", "signing-history", "export", historyPath, fmt.Sprintf("--home=%s", srcHomeDir)})
+		require.NoError(t, err)
+		err = app.Run([]string{"eotsd", "signing-history", "import", historyPath, fmt.Sprintf("--home=%s", dstHomeDir)})
+		require.ErrorIs(t, err, store.ErrConflictingSignRecord)
+	})
+}
+
+func withEOTSStore(t *testing.T, homeDir string, f func(es *store.EOTSStore)) {
+	cfg, err := config.LoadConfig(homeDir)
+	require.NoError(t, err)
+	dbBackend, err := cfg.DatabaseConfig.GetDbBackend()
+	require.NoError(t, err)
+	defer dbBackend.Close()
+
+	es, err := store.NewEOTSStore(dbBackend)
+	require.NoError(t, err)
+	f(es)
+}
@@ -2,7 +2,6 @@ package daemon
 
 import (
 	"fmt"
-	"net"
 	"path/filepath"
 
 	"github.com/lightningnetwork/lnd/signal"
@@ -27,7 +26,15 @@ var StartCommand = cli.Command{
 		},
 		cli.StringFlag{
 			Name:  rpcListenerFlag,
-			Usage: "The address that the RPC server listens to",
+			Usage: "The address that the RPC server listens to, e.g., 127.0.0.1:12582 or unix:///path/to/eotsd.sock",
+		},
+		cli.BoolFlag{
+			Name:  unsafeKeyExportFlag,
+			Usage: "Allow exporting the EOTS private keys through the KeyRecord RPC (unsafe, only for testing purposes)",
+		},
+		cli.BoolFlag{
+			Name:  unsafeDoubleSign,
+			Usage: "Allow signing EOTS without the double-sign protection through the UnsafeSignEOTS RPC, which leaks the EOTS private keys (unsafe, only for testing purposes)",
 		},
 	},
 	Action: startFn,
@@ -46,13 +53,20 @@ func startFn(ctx *cli.Context) error {
 
 	rpcListener := ctx.String(rpcListenerFlag)
 	if rpcListener != "" {
-		_, err := net.ResolveTCPAddr("tcp", rpcListener)
-		if err != nil {
+		if err := util.ValidateRPCAddress(rpcListener); err != nil {
 			return fmt.Errorf("invalid RPC listener address %s, %w", rpcListener, err)
 		}
 		cfg.RpcListener = rpcListener
 	}
 
+	if ctx.Bool(unsafeKeyExportFlag) {
+		cfg.UnsafeAllowKeyExport = true
+	}
+
+	if ctx.Bool(unsafeDoubleSign) {
+		cfg.UnsafeAllowDoubleSign = true
+	}
+
 	logger, err := log.NewRootLoggerWithFile(config.LogFile(homePath), cfg.LogLevel)
 	if err != nil {
 		return fmt.Errorf("failed to load the logger")
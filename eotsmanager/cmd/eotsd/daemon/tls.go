@@ -0,0 +1,121 @@
+package daemon
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+
+	eotscfg "github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/util"
+)
+
+const (
+	caCertFileName     = "ca.crt"
+	caKeyFileName      = "ca.key"
+	serverCertFileName = "server.crt"
+	serverKeyFileName  = "server.key"
+	clientCertFileName = "client.crt"
+	clientKeyFileName  = "client.key"
+)
+
+var GenTLSCertsCommand = cli.Command{
+	Name:      "gen-tls-certs",
+	Usage:     "Generate a self-signed CA and TLS certificate pairs for eotsd and fpd.",
+	UsageText: "gen-tls-certs --output-dir [output-dir]",
+	Description: `Generate a self-signed CA together with a server certificate pair for eotsd
+	and a client certificate pair for fpd, both signed by the CA. This is meant
+	for local setups; production setups should use certificates issued by a
+	proper CA.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  outputDirFlag,
+			Usage: "The directory where the certificates and keys are written to",
+			Value: filepath.Join(eotscfg.DefaultEOTSDir, "tls"),
+		},
+		cli.StringFlag{
+			Name:  hostsFlag,
+			Usage: "Comma-separated IP addresses and DNS names of the eotsd server certificate",
+			Value: defaultTLSHosts,
+		},
+		cli.IntFlag{
+			Name:  validDaysFlag,
+			Usage: "The number of days the certificates are valid for",
+			Value: defaultTLSValidDays,
+		},
+		cli.BoolFlag{
+			Name:  forceFlag,
+			Usage: "Override the existing certificates and keys",
+		},
+	},
+	Action: genTLSCerts,
+}
+
+func genTLSCerts(ctx *cli.Context) error {
+	outputDir, err := filepath.Abs(ctx.String(outputDirFlag))
+	if err != nil {
+		return err
+	}
+	outputDir = util.CleanAndExpandPath(outputDir)
+
+	validDays := ctx.Int(validDaysFlag)
+	if validDays <= 0 {
+		return fmt.Errorf("the number of valid days should be positive, got %d", validDays)
+	}
+	validFor := time.Duration(validDays) * 24 * time.Hour
+
+	var hosts []string
+	for _, h := range strings.Split(ctx.String(hostsFlag), ",") {
+		if h = strings.TrimSpace(h); h != "" {
+			hosts = append(hosts, h)
+		}
+	}
+	if len(hosts) == 0 {
+		return fmt.Errorf("at least one host should be specified for the server certificate")
+	}
+
+	if !ctx.Bool(forceFlag) {
+		for _, f := range []string{caCertFileName, caKeyFileName, serverCertFileName, serverKeyFileName, clientCertFileName, clientKeyFileName} {
+			if util.FileExists(filepath.Join(outputDir, f)) {
+				return fmt.Errorf("%s already exists in %s, use --%s to override", f, outputDir, forceFlag)
+			}
+		}
+	}
+
+	if err := util.MakeDirectory(outputDir); err != nil {
+		return err
+	}
+
+	ca, err := util.GenerateCA("eotsd-ca", validFor)
+	if err != nil {
+		return fmt.Errorf("failed to generate the CA: %w", err)
+	}
+	serverPair, err := util.GenerateServerCert(ca, "eotsd", hosts, validFor)
+	if err != nil {
+		return fmt.Errorf("failed to generate the server certificate: %w", err)
+	}
+	clientPair, err := util.GenerateClientCert(ca, "fpd", validFor)
+	if err != nil {
+		return fmt.Errorf("failed to generate the client certificate: %w", err)
+	}
+
+	if err := ca.WriteFiles(filepath.Join(outputDir, caCertFileName), filepath.Join(outputDir, caKeyFileName)); err != nil {
+		return err
+	}
+	if err := serverPair.WriteFiles(filepath.Join(outputDir, serverCertFileName), filepath.Join(outputDir, serverKeyFileName)); err != nil {
+		return err
+	}
+	if err := clientPair.WriteFiles(filepath.Join(outputDir, clientCertFileName), filepath.Join(outputDir, clientKeyFileName)); err != nil {
+		return err
+	}
+
+	fmt.Printf("TLS certificates are generated in %s\n\n", outputDir)
+	fmt.Printf("Add the following to eotsd.conf:\n[tls]\nCertFile = %s\nKeyFile = %s\nClientCAFile = %s\n\n",
+		filepath.Join(outputDir, serverCertFileName), filepath.Join(outputDir, serverKeyFileName), filepath.Join(outputDir, caCertFileName))
+	fmt.Printf("Add the following to fpd.conf:\n[eotsmanagertls]\nCAFile = %s\nCertFile = %s\nKeyFile = %s\n",
+		filepath.Join(outputDir, caCertFileName), filepath.Join(outputDir, clientCertFileName), filepath.Join(outputDir, clientKeyFileName))
+
+	return nil
+}
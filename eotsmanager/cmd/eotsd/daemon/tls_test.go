@@ -0,0 +1,86 @@
+package daemon_test
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	eotscfg "github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/util"
+)
+
+func TestGenTLSCerts(t *testing.T) {
+	outputDir := filepath.Join(t.TempDir(), "tls")
+	app := testApp()
+
+	outputFlag := fmt.Sprintf("--output-dir=%s", outputDir)
+	err := app.Run([]string{"eotsd", "gen-tls-certs", outputFlag})
+	require.NoError(t, err)
+
+	// the existing certificates are not overridden without --force
+	err = app.Run([]string{"eotsd", "gen-tls-certs", outputFlag})
+	require.Error(t, err)
+	err = app.Run([]string{"eotsd", "gen-tls-certs", outputFlag, "--force"})
+	require.NoError(t, err)
+
+	tlsCfg := &eotscfg.TLSConfig{
+		CertFile:     filepath.Join(outputDir, "server.crt"),
+		KeyFile:      filepath.Join(outputDir, "server.key"),
+		ClientCAFile: filepath.Join(outputDir, "ca.crt"),
+	}
+	require.NoError(t, tlsCfg.Validate())
+	serverTLSCfg, err := tlsCfg.ServerTLSConfig()
+	require.NoError(t, err)
+
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", serverTLSCfg)
+	require.NoError(t, err)
+	defer lis.Close()
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	handshake := func(clientTLSCfg *tls.Config) error {
+		conn, err := tls.Dial("tcp", lis.Addr().String(), clientTLSCfg)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		// the server verifies the client certificate after the client
+		// finishes the handshake, so read to get the result. The server
+		// closes the connection right after a successful handshake
+		_, err = conn.Read(make([]byte, 1))
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+
+	// a client presenting a certificate signed by the CA is accepted
+	clientTLSCfg, err := util.LoadClientTLSConfig(
+		filepath.Join(outputDir, "ca.crt"),
+		filepath.Join(outputDir, "client.crt"),
+		filepath.Join(outputDir, "client.key"),
+		"",
+	)
+	require.NoError(t, err)
+	err = handshake(clientTLSCfg)
+	require.NoError(t, err)
+
+	// a client without a certificate is rejected
+	noCertTLSCfg, err := util.LoadClientTLSConfig(filepath.Join(outputDir, "ca.crt"), "", "", "")
+	require.NoError(t, err)
+	err = handshake(noCertTLSCfg)
+	require.ErrorContains(t, err, "certificate")
+}
@@ -20,9 +20,11 @@ func main() {
 	app.Usage = "Extractable One Time Signature Daemon (eotsd)."
 	app.Commands = append(
 		app.Commands, dcli.StartCommand, dcli.InitCommand, dcli.SignSchnorrSig, dcli.VerifySchnorrSig,
-		dcli.ExportPoPCommand,
+		dcli.ExportPoPCommand, dcli.GenTLSCertsCommand, dcli.AuditCommand,
 	)
 	app.Commands = append(app.Commands, dcli.KeysCommands...)
+	app.Commands = append(app.Commands, dcli.SigningHistoryCommands...)
+	app.Commands = append(app.Commands, dcli.EOTSCommands...)
 
 	if err := app.Run(os.Args); err != nil {
 		fatal(err)
@@ -2,9 +2,9 @@ package config
 
 import (
 	"fmt"
-	"net"
 	"path/filepath"
 	"strconv"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -22,6 +22,8 @@ const (
 	defaultConfigFileName = "eotsd.conf"
 	DefaultRPCPort        = 12582
 	defaultKeyringBackend = keyring.BackendTest
+	// the audit log entries are kept for 90 days by default
+	defaultAuditLogRetention = 90 * 24 * time.Hour
 )
 
 var (
@@ -35,12 +37,18 @@ var (
 )
 
 type Config struct {
-	LogLevel       string          `long:"loglevel" description:"Logging level for all subsystems" choice:"trace" choice:"debug" choice:"info" choice:"warn" choice:"error" choice:"fatal"`
-	KeyringBackend string          `long:"keyring-type" description:"Type of keyring to use"`
-	RpcListener    string          `long:"rpclistener" description:"the listener for RPC connections, e.g., 127.0.0.1:1234"`
-	Metrics        *metrics.Config `group:"metrics" namespace:"metrics"`
+	LogLevel              string          `long:"loglevel" description:"Logging level for all subsystems" choice:"trace" choice:"debug" choice:"info" choice:"warn" choice:"error" choice:"fatal"`
+	KeyringBackend        string          `long:"keyring-type" description:"Type of keyring to use"`
+	RpcListener           string          `long:"rpclistener" description:"the listener for RPC connections, e.g., 127.0.0.1:1234 or unix:///path/to/eotsd.sock"`
+	UnsafeAllowKeyExport  bool            `long:"unsafe-allow-key-export" description:"Allow exporting the EOTS private keys through the KeyRecord RPC (unsafe, only for testing purposes)"`
+	UnsafeAllowDoubleSign bool            `long:"unsafe-allow-double-sign" description:"Allow signing EOTS without the double-sign protection through the UnsafeSignEOTS RPC, which leaks the EOTS private keys (unsafe, only for testing purposes)"`
+	PolicyFile            string          `long:"policyfile" description:"Path to the JSON file of the per-client signing authorization policy; all the clients are allowed if empty"`
+	AuditLogRetention     time.Duration   `long:"auditlogretention" description:"The duration for which the signing audit log entries are kept; they are never pruned if 0"`
+	Metrics               *metrics.Config `group:"metrics" namespace:"metrics"`
 
 	DatabaseConfig *DBConfig `group:"dbconfig" namespace:"dbconfig"`
+
+	TLS *TLSConfig `group:"tls" namespace:"tls"`
 }
 
 // LoadConfig initializes and parses the config using a config file and command
@@ -80,8 +88,7 @@ func LoadConfig(homePath string) (*Config, error) {
 // illegal values or combination of values are set. All file system paths are
 // normalized. The cleaned up config is returned on success.
 func (cfg *Config) Validate() error {
-	_, err := net.ResolveTCPAddr("tcp", cfg.RpcListener)
-	if err != nil {
+	if err := util.ValidateRPCAddress(cfg.RpcListener); err != nil {
 		return fmt.Errorf("invalid RPC listener address %s, %w", cfg.RpcListener, err)
 	}
 
@@ -89,6 +96,10 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("the keyring backend should not be empty")
 	}
 
+	if cfg.AuditLogRetention < 0 {
+		return fmt.Errorf("the audit log retention should not be negative")
+	}
+
 	if cfg.Metrics == nil {
 		return fmt.Errorf("empty metrics config")
 	}
@@ -97,6 +108,10 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("invalid metrics config")
 	}
 
+	if err := cfg.TLS.Validate(); err != nil {
+		return fmt.Errorf("invalid TLS config: %w", err)
+	}
+
 	return nil
 }
 
@@ -122,11 +137,13 @@ func DefaultConfig() *Config {
 
 func DefaultConfigWithHomePath(homePath string) *Config {
 	cfg := &Config{
-		LogLevel:       defaultLogLevel,
-		KeyringBackend: defaultKeyringBackend,
-		DatabaseConfig: DefaultDBConfigWithHomePath(homePath),
-		RpcListener:    defaultRpcListener,
-		Metrics:        metrics.DefaultEotsConfig(),
+		LogLevel:          defaultLogLevel,
+		KeyringBackend:    defaultKeyringBackend,
+		DatabaseConfig:    DefaultDBConfigWithHomePath(homePath),
+		RpcListener:       defaultRpcListener,
+		AuditLogRetention: defaultAuditLogRetention,
+		Metrics:           metrics.DefaultEotsConfig(),
+		TLS:               DefaultTLSConfig(),
 	}
 	if err := cfg.Validate(); err != nil {
 		panic(err)
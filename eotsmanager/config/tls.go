@@ -0,0 +1,50 @@
+package config
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	"github.com/babylonlabs-io/finality-provider/util"
+)
+
+// TLSConfig is the TLS config of the RPC server
+type TLSConfig struct {
+	// CertFile and KeyFile are the certificate and the private key of the
+	// RPC server. TLS is disabled if they are empty
+	CertFile string `long:"certfile" description:"Path to the TLS certificate of the RPC server; TLS is disabled if empty"`
+	KeyFile  string `long:"keyfile" description:"Path to the TLS private key of the RPC server"`
+
+	// ClientCAFile is the CA certificate to verify the client certificates.
+	// Client authentication (mutual TLS) is disabled if it is empty
+	ClientCAFile string `long:"clientcafile" description:"Path to the CA certificate to verify client certificates; client authentication is disabled if empty"`
+}
+
+func DefaultTLSConfig() *TLSConfig {
+	return &TLSConfig{}
+}
+
+// Enabled returns whether TLS is enabled for the RPC server
+func (cfg *TLSConfig) Enabled() bool {
+	return cfg != nil && cfg.CertFile != ""
+}
+
+func (cfg *TLSConfig) Validate() error {
+	if cfg == nil {
+		return nil
+	}
+
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		return fmt.Errorf("the TLS certificate and key files should be specified together")
+	}
+
+	if cfg.ClientCAFile != "" && cfg.CertFile == "" {
+		return fmt.Errorf("client authentication requires the TLS certificate and key files of the server")
+	}
+
+	return nil
+}
+
+// ServerTLSConfig loads the TLS config of the RPC server
+func (cfg *TLSConfig) ServerTLSConfig() (*tls.Config, error) {
+	return util.LoadServerTLSConfig(cfg.CertFile, cfg.KeyFile, cfg.ClientCAFile)
+}
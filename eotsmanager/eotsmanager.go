@@ -7,6 +7,11 @@ import (
 	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
 )
 
+// APIVersion is the version of the RPC API of the EOTS manager. It is bumped
+// whenever the API changes in a way that the clients and the servers of
+// different versions cannot work together
+const APIVersion uint32 = 1
+
 type EOTSManager interface {
 	// CreateKey generates a key pair at the given name and persists it in storage.
 	// The key pair is formatted by BIP-340 (Schnorr Signatures)
@@ -21,16 +26,26 @@ type EOTSManager interface {
 	// block height
 	CreateRandomnessPairList(uid []byte, chainID []byte, startHeight uint64, num uint32, passphrase string) ([]*btcec.FieldVal, error)
 
-	// KeyRecord returns the finality provider record
-	// It fails if the finality provider does not exist or passPhrase is incorrect
-	KeyRecord(uid []byte, passphrase string) (*types.KeyRecord, error)
+	// PubKeyRecord returns the name and the public key of the EOTS key of the given uid
+	// without accessing the private key
+	// It fails if the finality provider does not exist
+	PubKeyRecord(uid []byte) (*types.PubKeyRecord, error)
 
 	// SignEOTS signs an EOTS using the private key of the finality provider and the corresponding
 	// secret randomness of the given chain at the given height
 	// It fails if the finality provider does not exist or there's no randomness committed to the given height
 	// or passPhrase is incorrect
+	// It also fails if a different message has been signed at the same height before, while signing
+	// the same message again returns the same signature
 	SignEOTS(uid []byte, chainID []byte, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error)
 
+	// SignEOTSBatch signs a batch of EOTS using the private key of the finality provider and
+	// the corresponding secret randomness of the given chain at the heights of the messages
+	// The signatures are returned in the same order as the messages
+	// It fails as a whole if any of the messages conflicts with a message signed at the same
+	// height before, or the finality provider does not exist or passPhrase is incorrect
+	SignEOTSBatch(uid []byte, chainID []byte, msgs []*types.HeightMsg, passphrase string) ([]*btcec.ModNScalar, error)
+
 	// SignSchnorrSig signs a Schnorr signature using the private key of the finality provider
 	// It fails if the finality provider does not exist or the message size is not 32 bytes
 	// or passPhrase is incorrect
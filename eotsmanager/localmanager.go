@@ -1,10 +1,16 @@
 package eotsmanager
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	"runtime"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/babylonlabs-io/finality-provider/metrics"
 
@@ -12,6 +18,7 @@ import (
 	bbntypes "github.com/babylonlabs-io/babylon/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	sdkcodec "github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/go-bip39"
@@ -27,54 +34,95 @@ import (
 const (
 	secp256k1Type       = "secp256k1"
 	MnemonicEntropySize = 256
+
+	// parallelRandDerivationThreshold is the minimum number of public randomness
+	// to be derived in parallel by several workers
+	parallelRandDerivationThreshold = 64
 )
 
 var _ EOTSManager = &LocalEOTSManager{}
 
 type LocalEOTSManager struct {
-	mu     sync.Mutex
-	kr     keyring.Keyring
-	es     *store.EOTSStore
-	logger *zap.Logger
-	// input is to send passphrase to kr
-	input   *strings.Reader
+	homeDir        string
+	keyringBackend string
+	cdc            sdkcodec.Codec
+	// memKr is the only keyring instance of the memory backend, which
+	// loses its keys if it is re-initialized and never asks for passphrases
+	memKr keyring.Keyring
+
+	// keyLocks holds a mutex per key name so that operations on
+	// different keys never block each other
+	keyLocks sync.Map
+
+	es      *store.EOTSStore
+	logger  *zap.Logger
 	metrics *metrics.EotsMetrics
 }
 
 func NewLocalEOTSManager(homeDir, keyringBackend string, dbbackend kvdb.Backend, logger *zap.Logger) (*LocalEOTSManager, error) {
-	inputReader := strings.NewReader("")
-
 	es, err := store.NewEOTSStore(dbbackend)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize store: %w", err)
 	}
 
-	kr, err := initKeyring(homeDir, keyringBackend, inputReader)
+	cdc := codec.MakeCodec()
+
+	// make sure the keyring can be initialized before serving any request
+	kr, err := initKeyring(homeDir, keyringBackend, strings.NewReader(""), cdc)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize keyring: %w", err)
 	}
+	var memKr keyring.Keyring
+	if keyringBackend == keyring.BackendMemory {
+		memKr = kr
+	}
 
 	eotsMetrics := metrics.NewEotsMetrics()
 
 	return &LocalEOTSManager{
-		kr:      kr,
-		es:      es,
-		logger:  logger,
-		input:   inputReader,
-		metrics: eotsMetrics,
+		homeDir:        homeDir,
+		keyringBackend: keyringBackend,
+		cdc:            cdc,
+		memKr:          memKr,
+		es:             es,
+		logger:         logger,
+		metrics:        eotsMetrics,
 	}, nil
 }
 
-func initKeyring(homeDir, keyringBackend string, inputReader *strings.Reader) (keyring.Keyring, error) {
+func initKeyring(homeDir, keyringBackend string, inputReader io.Reader, cdc sdkcodec.Codec) (keyring.Keyring, error) {
 	return keyring.New(
 		"eots-manager",
 		keyringBackend,
 		homeDir,
 		inputReader,
-		codec.MakeCodec(),
+		cdc,
 	)
 }
 
+// keyringWithInput returns a keyring instance reading the passphrase from its
+// own input, so that the passphrases of concurrent operations never interleave
+func (lm *LocalEOTSManager) keyringWithInput(input string) (keyring.Keyring, error) {
+	if lm.memKr != nil {
+		return lm.memKr, nil
+	}
+
+	kr, err := initKeyring(lm.homeDir, lm.keyringBackend, strings.NewReader(input), lm.cdc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize keyring: %w", err)
+	}
+
+	return kr, nil
+}
+
+// lockKey locks the mutex of the given key name and returns the unlock function
+func (lm *LocalEOTSManager) lockKey(name string) func() {
+	mu, _ := lm.keyLocks.LoadOrStore(name, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+
+	return mu.(*sync.Mutex).Unlock
+}
+
 func (lm *LocalEOTSManager) CreateKey(name, passphrase, hdPath string) ([]byte, error) {
 	mnemonic, err := NewMnemonic()
 	if err != nil {
@@ -105,44 +153,179 @@ func NewMnemonic() (string, error) {
 }
 
 func (lm *LocalEOTSManager) CreateKeyWithMnemonic(name, passphrase, hdPath, mnemonic string) (*bbntypes.BIP340PubKey, error) {
-	if lm.keyExists(name) {
+	unlock := lm.lockKey(name)
+	defer unlock()
+
+	// we need to repeat the passphrase to mock the re-entry
+	// as when creating an account, passphrase will be asked twice
+	// by the keyring
+	kr, err := lm.keyringWithInput(passphrase + "\n" + passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := kr.Key(name); err == nil {
 		return nil, eotstypes.ErrFinalityProviderAlreadyExisted
 	}
 
-	keyringAlgos, _ := lm.kr.SupportedAlgorithms()
+	keyringAlgos, _ := kr.SupportedAlgorithms()
 	algo, err := keyring.NewSigningAlgoFromString(secp256k1Type, keyringAlgos)
 	if err != nil {
 		return nil, err
 	}
 
-	// we need to repeat the passphrase to mock the re-entry
-	// as when creating an account, passphrase will be asked twice
-	// by the keyring
-	lm.input.Reset(passphrase + "\n" + passphrase)
-	record, err := lm.kr.NewAccount(name, mnemonic, passphrase, hdPath, algo)
+	record, err := kr.NewAccount(name, mnemonic, passphrase, hdPath, algo)
 	if err != nil {
 		return nil, err
 	}
 
-	eotsPk, err := loadBIP340PubKeyFromKeyringRecord(record)
+	eotsPk, err := lm.saveKeyName(kr, record)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := lm.es.AddEOTSKeyName(eotsPk.MustToBTCPK(), name); err != nil {
+	lm.logger.Info(
+		"successfully created an EOTS key",
+		zap.String("key name", name),
+		zap.String("pk", eotsPk.MarshalHex()),
+	)
+	lm.metrics.IncrementEotsCreatedKeysCounter()
+
+	return eotsPk, nil
+}
+
+// ImportArmoredKey imports the ASCII armored private key encrypted by armorPassphrase
+// into the keyring under the given name
+func (lm *LocalEOTSManager) ImportArmoredKey(name, armor, armorPassphrase, passphrase string) (*bbntypes.BIP340PubKey, error) {
+	return lm.importKey(name, passphrase, func(kr keyring.Keyring) error {
+		if err := kr.ImportPrivKey(name, armor, armorPassphrase); err != nil {
+			return fmt.Errorf("failed to import the armored key: %w", err)
+		}
+		return nil
+	})
+}
+
+// ImportPrivKey imports the private key into the keyring under the given name
+func (lm *LocalEOTSManager) ImportPrivKey(name string, privKey *btcec.PrivateKey, passphrase string) (*bbntypes.BIP340PubKey, error) {
+	return lm.importKey(name, passphrase, func(kr keyring.Keyring) error {
+		if err := kr.ImportPrivKeyHex(name, hex.EncodeToString(privKey.Serialize()), secp256k1Type); err != nil {
+			return fmt.Errorf("failed to import the private key: %w", err)
+		}
+		return nil
+	})
+}
+
+func (lm *LocalEOTSManager) importKey(name, passphrase string, importFn func(kr keyring.Keyring) error) (*bbntypes.BIP340PubKey, error) {
+	unlock := lm.lockKey(name)
+	defer unlock()
+
+	kr, err := lm.keyringWithInput(passphrase + "\n" + passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := kr.Key(name); err == nil {
+		return nil, eotstypes.ErrFinalityProviderAlreadyExisted
+	}
+
+	if err := importFn(kr); err != nil {
+		return nil, err
+	}
+
+	record, err := kr.Key(name)
+	if err != nil {
+		return nil, err
+	}
+
+	eotsPk, err := lm.saveKeyName(kr, record)
+	if err != nil {
 		return nil, err
 	}
 
 	lm.logger.Info(
-		"successfully created an EOTS key",
+		"successfully imported an EOTS key",
 		zap.String("key name", name),
 		zap.String("pk", eotsPk.MarshalHex()),
 	)
-	lm.metrics.IncrementEotsCreatedKeysCounter()
 
 	return eotsPk, nil
 }
 
+// saveKeyName saves the name of the key that has just been added to the keyring,
+// or removes the key from the keyring if it cannot be saved, e.g., it is a
+// deleted key added back with a different name
+func (lm *LocalEOTSManager) saveKeyName(kr keyring.Keyring, record *keyring.Record) (*bbntypes.BIP340PubKey, error) {
+	eotsPk, err := loadBIP340PubKeyFromKeyringRecord(record)
+	if err == nil {
+		err = lm.es.AddEOTSKeyName(eotsPk.MustToBTCPK(), record.Name)
+	}
+	if err != nil {
+		if delErr := kr.Delete(record.Name); delErr != nil {
+			lm.logger.Error("failed to remove the key from the keyring",
+				zap.String("key name", record.Name), zap.Error(delErr))
+		}
+		return nil, err
+	}
+
+	return eotsPk, nil
+}
+
+// ListKeys returns the names and the public keys of all the EOTS keys
+func (lm *LocalEOTSManager) ListKeys() ([]*eotstypes.PubKeyRecord, error) {
+	keyNames, err := lm.es.GetAllEOTSKeyNames()
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*eotstypes.PubKeyRecord, 0, len(keyNames))
+	for _, kn := range keyNames {
+		pk, err := schnorr.ParsePubKey(kn.PubKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EOTS public key %x: %w", kn.PubKey, err)
+		}
+		records = append(records, &eotstypes.PubKeyRecord{
+			Name:   kn.Name,
+			PubKey: pk,
+		})
+	}
+
+	return records, nil
+}
+
+// DeleteKey deletes the EOTS key from the keyring. The public key is tombstoned
+// so that it can never be added back with another name, while the signing
+// records of the key are kept
+func (lm *LocalEOTSManager) DeleteKey(fpPk []byte, passphrase string) error {
+	keyName, err := lm.es.GetEOTSKeyName(fpPk)
+	if err != nil {
+		return err
+	}
+
+	unlock := lm.lockKey(keyName)
+	defer unlock()
+
+	kr, err := lm.keyringWithInput(passphrase)
+	if err != nil {
+		return err
+	}
+
+	if err := kr.Delete(keyName); err != nil {
+		return fmt.Errorf("failed to delete the key %s from the keyring: %w", keyName, err)
+	}
+
+	if err := lm.es.DeleteEOTSKeyName(fpPk); err != nil {
+		return err
+	}
+
+	lm.logger.Info(
+		"successfully deleted an EOTS key",
+		zap.String("key name", keyName),
+		zap.String("pk", hex.EncodeToString(fpPk)),
+	)
+
+	return nil
+}
+
 func loadBIP340PubKeyFromKeyringRecord(record *keyring.Record) (*bbntypes.BIP340PubKey, error) {
 	pubKey, err := record.GetPubKey()
 	if err != nil {
@@ -163,21 +346,43 @@ func loadBIP340PubKeyFromKeyringRecord(record *keyring.Record) (*bbntypes.BIP340
 	}
 }
 
-// TODO the current implementation is a PoC, which does not contain any anti-slasher mechanism
-//
-//	a simple anti-slasher mechanism could be that the manager remembers the tuple (fpPk, chainID, height) or
-//	the hash of each generated randomness and return error if the same randomness is requested twice
+// CreateRandomnessPairList only derives public randomness, which is safe to be
+// requested many times. The protection against using the same randomness twice
+// is enforced by SignEOTS
+// The key is loaded only once for the whole list, and large lists are derived
+// by several workers in parallel
 func (lm *LocalEOTSManager) CreateRandomnessPairList(fpPk []byte, chainID []byte, startHeight uint64, num uint32, passphrase string) ([]*btcec.FieldVal, error) {
-	prList := make([]*btcec.FieldVal, 0, num)
+	prList := make([]*btcec.FieldVal, num)
+
+	if num > 0 {
+		start := time.Now()
 
-	for i := uint32(0); i < num; i++ {
-		height := startHeight + uint64(i)
-		_, pubRand, err := lm.getRandomnessPair(fpPk, chainID, height, passphrase)
+		privKey, err := lm.getEOTSPrivKey(fpPk, passphrase)
 		if err != nil {
 			return nil, err
 		}
+		keyBytes := privKey.Serialize()
 
-		prList = append(prList, pubRand)
+		numWorkers := 1
+		if num >= parallelRandDerivationThreshold {
+			numWorkers = runtime.NumCPU()
+		}
+		chunkSize := (int(num) + numWorkers - 1) / numWorkers
+
+		var wg sync.WaitGroup
+		for from := 0; from < int(num); from += chunkSize {
+			to := min(from+chunkSize, int(num))
+			wg.Add(1)
+			go func(from, to int) {
+				defer wg.Done()
+				for i := from; i < to; i++ {
+					_, prList[i] = randgenerator.GenerateRandomness(keyBytes, chainID, startHeight+uint64(i))
+				}
+			}(from, to)
+		}
+		wg.Wait()
+
+		lm.metrics.ObserveEotsFpRandomnessDerivationTime(hex.EncodeToString(fpPk), time.Since(start))
 	}
 	lm.metrics.IncrementEotsFpTotalGeneratedRandomnessCounter(hex.EncodeToString(fpPk))
 	lm.metrics.SetEotsFpLastGeneratedRandomnessHeight(hex.EncodeToString(fpPk), float64(startHeight))
@@ -185,10 +390,68 @@ func (lm *LocalEOTSManager) CreateRandomnessPairList(fpPk []byte, chainID []byte
 	return prList, nil
 }
 
+// SignEOTS signs the message and persists a signing record of (fpPk, chainID, height)
+// so that a different message can never be signed at the same height, which would
+// leak the EOTS private key. Signing the identical message again is allowed so that
+// retries still work
 func (lm *LocalEOTSManager) SignEOTS(fpPk []byte, chainID []byte, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error) {
-	privRand, _, err := lm.getRandomnessPair(fpPk, chainID, height, passphrase)
+	msgHash := sha256.Sum256(msg)
+	signedHash, err := lm.es.GetSignRecord(fpPk, chainID, height)
+	if err != nil && !errors.Is(err, store.ErrSignRecordNotFound) {
+		return nil, fmt.Errorf("failed to get the signing record: %w", err)
+	}
+	if signedHash != nil && !bytes.Equal(signedHash, msgHash[:]) {
+		return nil, lm.doubleSignErr(fpPk, chainID, height)
+	}
+
+	sig, err := lm.signEOTS(fpPk, chainID, msg, height, passphrase)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get private randomness: %w", err)
+		return nil, err
+	}
+
+	// the signature is only released after the signing record is persisted,
+	// the store rejects the record if a conflicting one is saved in the meantime
+	if err := lm.es.SaveSignRecord(fpPk, chainID, height, msgHash[:]); err != nil {
+		if errors.Is(err, store.ErrConflictingSignRecord) {
+			return nil, lm.doubleSignErr(fpPk, chainID, height)
+		}
+		return nil, fmt.Errorf("failed to save the signing record: %w", err)
+	}
+
+	return sig, nil
+}
+
+// UnsafeSignEOTS signs the message without checking or persisting any signing record
+// NOTE: this should only be used for testing purposes as it offers no double-sign protection
+func (lm *LocalEOTSManager) UnsafeSignEOTS(fpPk []byte, chainID []byte, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error) {
+	return lm.signEOTS(fpPk, chainID, msg, height, passphrase)
+}
+
+// SignEOTSBatch signs the messages of the same key and chain at their heights
+// while loading the key only once. The signing records of the whole batch are
+// persisted atomically before any signature is released, so the batch is refused
+// as a whole if any of the messages conflicts with a previously signed one
+func (lm *LocalEOTSManager) SignEOTSBatch(fpPk []byte, chainID []byte, msgs []*eotstypes.HeightMsg, passphrase string) ([]*btcec.ModNScalar, error) {
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("no message to sign")
+	}
+
+	records := make([]*store.SignRecord, 0, len(msgs))
+	for _, m := range msgs {
+		msgHash := sha256.Sum256(m.Msg)
+		signedHash, err := lm.es.GetSignRecord(fpPk, chainID, m.Height)
+		if err != nil && !errors.Is(err, store.ErrSignRecordNotFound) {
+			return nil, fmt.Errorf("failed to get the signing record: %w", err)
+		}
+		if signedHash != nil && !bytes.Equal(signedHash, msgHash[:]) {
+			return nil, lm.doubleSignErr(fpPk, chainID, m.Height)
+		}
+		records = append(records, &store.SignRecord{
+			PubKey:  fpPk,
+			ChainID: chainID,
+			Height:  m.Height,
+			MsgHash: msgHash[:],
+		})
 	}
 
 	privKey, err := lm.getEOTSPrivKey(fpPk, passphrase)
@@ -196,6 +459,43 @@ func (lm *LocalEOTSManager) SignEOTS(fpPk []byte, chainID []byte, msg []byte, he
 		return nil, fmt.Errorf("failed to get EOTS private key: %w", err)
 	}
 
+	sigs := make([]*btcec.ModNScalar, 0, len(msgs))
+	for _, m := range msgs {
+		sig, err := lm.signEOTSWithPrivKey(privKey, fpPk, chainID, m.Msg, m.Height)
+		if err != nil {
+			return nil, err
+		}
+		sigs = append(sigs, sig)
+	}
+
+	if err := lm.es.SaveSignRecords(records); err != nil {
+		if errors.Is(err, store.ErrConflictingSignRecord) {
+			lm.logger.Error(
+				"refused to sign a conflicting batch of messages",
+				zap.String("pk", hex.EncodeToString(fpPk)),
+				zap.String("chain_id", string(chainID)),
+				zap.Error(err),
+			)
+			return nil, fmt.Errorf("%w: %s", eotstypes.ErrDoubleSign, err.Error())
+		}
+		return nil, fmt.Errorf("failed to save the signing records: %w", err)
+	}
+
+	return sigs, nil
+}
+
+func (lm *LocalEOTSManager) signEOTS(fpPk []byte, chainID []byte, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error) {
+	privKey, err := lm.getEOTSPrivKey(fpPk, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get EOTS private key: %w", err)
+	}
+
+	return lm.signEOTSWithPrivKey(privKey, fpPk, chainID, msg, height)
+}
+
+func (lm *LocalEOTSManager) signEOTSWithPrivKey(privKey *btcec.PrivateKey, fpPk []byte, chainID []byte, msg []byte, height uint64) (*btcec.ModNScalar, error) {
+	privRand, _ := randgenerator.GenerateRandomness(privKey.Serialize(), chainID, height)
+
 	// Update metrics
 	lm.metrics.IncrementEotsFpTotalEotsSignCounter(hex.EncodeToString(fpPk))
 	lm.metrics.SetEotsFpLastEotsSignHeight(hex.EncodeToString(fpPk), float64(height))
@@ -203,6 +503,18 @@ func (lm *LocalEOTSManager) SignEOTS(fpPk []byte, chainID []byte, msg []byte, he
 	return eots.Sign(privKey, privRand, msg)
 }
 
+func (lm *LocalEOTSManager) doubleSignErr(fpPk []byte, chainID []byte, height uint64) error {
+	lm.logger.Error(
+		"refused to sign a conflicting message",
+		zap.String("pk", hex.EncodeToString(fpPk)),
+		zap.String("chain_id", string(chainID)),
+		zap.Uint64("height", height),
+	)
+
+	return fmt.Errorf("%w: pk %s, chain id %s, height %d",
+		eotstypes.ErrDoubleSign, hex.EncodeToString(fpPk), string(chainID), height)
+}
+
 func (lm *LocalEOTSManager) SignSchnorrSig(fpPk []byte, msg []byte, passphrase string) (*schnorr.Signature, error) {
 	privKey, err := lm.getEOTSPrivKey(fpPk, passphrase)
 	if err != nil {
@@ -220,8 +532,7 @@ func (lm *LocalEOTSManager) signSchnorrSigFromPrivKey(privKey *btcec.PrivateKey,
 }
 
 func (lm *LocalEOTSManager) SignSchnorrSigFromKeyname(keyName, passphrase string, msg []byte) (*schnorr.Signature, *bbntypes.BIP340PubKey, error) {
-	lm.input.Reset(passphrase)
-	k, err := lm.kr.Key(keyName)
+	k, err := lm.loadKeyRecord(keyName, passphrase)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to load keyring record for key %s: %w", keyName, err)
 	}
@@ -247,16 +558,31 @@ func (lm *LocalEOTSManager) Close() error {
 	return nil
 }
 
-// getRandomnessPair returns a randomness pair generated based on the given finality provider key, chainID and height
-func (lm *LocalEOTSManager) getRandomnessPair(fpPk []byte, chainID []byte, height uint64, passphrase string) (*eots.PrivateRand, *eots.PublicRand, error) {
-	record, err := lm.KeyRecord(fpPk, passphrase)
+// PubKeyRecord returns the name and the public key of the EOTS key from the
+// store so that the keyring is not touched
+func (lm *LocalEOTSManager) PubKeyRecord(fpPk []byte) (*eotstypes.PubKeyRecord, error) {
+	name, err := lm.es.GetEOTSKeyName(fpPk)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+	pk, err := bbntypes.NewBIP340PubKey(fpPk)
+	if err != nil {
+		return nil, err
 	}
-	privRand, pubRand := randgenerator.GenerateRandomness(record.PrivKey.Serialize(), chainID, height)
-	return privRand, pubRand, nil
+	btcPk, err := pk.ToBTCPK()
+	if err != nil {
+		return nil, err
+	}
+
+	return &eotstypes.PubKeyRecord{
+		Name:   name,
+		PubKey: btcPk,
+	}, nil
 }
 
+// KeyRecord returns the key record including the private key
+// NOTE: it is not part of the EOTSManager interface as the private key
+// should not leave the EOTS manager
 // TODO: we ignore passPhrase in local implementation for now
 func (lm *LocalEOTSManager) KeyRecord(fpPk []byte, passphrase string) (*eotstypes.KeyRecord, error) {
 	name, err := lm.es.GetEOTSKeyName(fpPk)
@@ -275,15 +601,12 @@ func (lm *LocalEOTSManager) KeyRecord(fpPk []byte, passphrase string) (*eotstype
 }
 
 func (lm *LocalEOTSManager) getEOTSPrivKey(fpPk []byte, passphrase string) (*btcec.PrivateKey, error) {
-	lm.mu.Lock()
-	defer lm.mu.Unlock()
 	keyName, err := lm.es.GetEOTSKeyName(fpPk)
 	if err != nil {
 		return nil, err
 	}
 
-	lm.input.Reset(passphrase)
-	k, err := lm.kr.Key(keyName)
+	k, err := lm.loadKeyRecord(keyName, passphrase)
 	if err != nil {
 		return nil, err
 	}
@@ -291,6 +614,20 @@ func (lm *LocalEOTSManager) getEOTSPrivKey(fpPk []byte, passphrase string) (*btc
 	return eotsPrivKeyFromRecord(k)
 }
 
+// loadKeyRecord loads the keyring record of the given key name while holding
+// the lock of the key
+func (lm *LocalEOTSManager) loadKeyRecord(keyName, passphrase string) (*keyring.Record, error) {
+	unlock := lm.lockKey(keyName)
+	defer unlock()
+
+	kr, err := lm.keyringWithInput(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return kr.Key(keyName)
+}
+
 func eotsPrivKeyFromRecord(k *keyring.Record) (*btcec.PrivateKey, error) {
 	privKeyCached := k.GetLocal().PrivKey.GetCachedValue()
 
@@ -303,8 +640,3 @@ func eotsPrivKeyFromRecord(k *keyring.Record) (*btcec.PrivateKey, error) {
 		return nil, fmt.Errorf("unsupported key type in keyring")
 	}
 }
-
-func (lm *LocalEOTSManager) keyExists(name string) bool {
-	_, err := lm.kr.Key(name)
-	return err == nil
-}
@@ -1,12 +1,17 @@
 package eotsmanager_test
 
 import (
+	"errors"
 	"math/rand"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 
+	"github.com/babylonlabs-io/babylon/crypto/eots"
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
@@ -48,6 +53,12 @@ func FuzzCreateKey(f *testing.F) {
 		require.NoError(t, err)
 		require.Equal(t, fpName, fpRecord.Name)
 
+		pkRecord, err := lm.PubKeyRecord(fpPk)
+		require.NoError(t, err)
+		require.Equal(t, fpName, pkRecord.Name)
+		require.Equal(t, fpPk, schnorr.SerializePubKey(pkRecord.PubKey))
+		require.Equal(t, schnorr.SerializePubKey(fpRecord.PrivKey.PubKey()), schnorr.SerializePubKey(pkRecord.PubKey))
+
 		sig, err := lm.SignSchnorrSig(fpPk, datagen.GenRandomByteArray(r, 32), passphrase)
 		require.NoError(t, err)
 		require.NotNil(t, sig)
@@ -80,15 +91,213 @@ func FuzzCreateRandomnessPairList(f *testing.F) {
 
 		chainID := datagen.GenRandomByteArray(r, 10)
 		startHeight := datagen.RandomInt(r, 100)
-		num := r.Intn(10) + 1
+		// large lists are derived in parallel
+		num := r.Intn(200) + 1
 		pubRandList, err := lm.CreateRandomnessPairList(fpPk, chainID, startHeight, uint32(num), passphrase)
 		require.NoError(t, err)
 		require.Len(t, pubRandList, num)
 
+		btcPk, err := schnorr.ParsePubKey(fpPk)
+		require.NoError(t, err)
 		for i := 0; i < num; i++ {
-			sig, err := lm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), startHeight+uint64(i), passphrase)
+			msg := datagen.GenRandomByteArray(r, 32)
+			sig, err := lm.SignEOTS(fpPk, chainID, msg, startHeight+uint64(i), passphrase)
 			require.NoError(t, err)
 			require.NotNil(t, sig)
+			// the signature is made with the randomness at the same height
+			err = eots.Verify(btcPk, pubRandList[i], msg, sig)
+			require.NoError(t, err)
+		}
+	})
+}
+
+// FuzzSignEOTSDoubleSign tests that signing a conflicting message
+// at the same height is refused while re-signing is idempotent
+func FuzzSignEOTSDoubleSign(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		fpName := testutil.GenRandomHexStr(r, 4)
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+		dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			dbBackend.Close()
+			err := os.RemoveAll(homeDir)
+			require.NoError(t, err)
+		}()
+		lm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+
+		fpPk, err := lm.CreateKey(fpName, passphrase, hdPath)
+		require.NoError(t, err)
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		height := datagen.RandomInt(r, 100)
+		msg := datagen.GenRandomByteArray(r, 32)
+
+		sig, err := lm.SignEOTS(fpPk, chainID, msg, height, passphrase)
+		require.NoError(t, err)
+
+		// signing the same message again returns the same signature
+		sig2, err := lm.SignEOTS(fpPk, chainID, msg, height, passphrase)
+		require.NoError(t, err)
+		require.True(t, sig.Equals(sig2))
+
+		// signing a different message at the same height is refused
+		_, err = lm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), height, passphrase)
+		require.ErrorIs(t, err, types.ErrDoubleSign)
+
+		// the unsafe API still allows it
+		_, err = lm.UnsafeSignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), height, passphrase)
+		require.NoError(t, err)
+	})
+}
+
+// FuzzConcurrentSigning tests that concurrent requests over different keys using
+// a passphrase-protected keyring do not interfere with each other
+func FuzzConcurrentSigning(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 3)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+		dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			dbBackend.Close()
+			err := os.RemoveAll(homeDir)
+			require.NoError(t, err)
+		}()
+		lm, err := eotsmanager.NewLocalEOTSManager(homeDir, keyring.BackendFile, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+
+		numKeys := r.Intn(3) + 2
+		fpPks := make([][]byte, numKeys)
+		for i := 0; i < numKeys; i++ {
+			fpPk, err := lm.CreateKey(testutil.GenRandomHexStr(r, 4), passphrase, hdPath)
+			require.NoError(t, err)
+			fpPks[i] = fpPk
+		}
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		startHeight := datagen.RandomInt(r, 100)
+		msg := datagen.GenRandomByteArray(r, 32)
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 3*numKeys)
+		for i := 0; i < numKeys; i++ {
+			fpPk := fpPks[i]
+			height := startHeight + uint64(i)
+			wg.Add(3)
+			go func() {
+				defer wg.Done()
+				sig, err := lm.SignSchnorrSig(fpPk, msg, passphrase)
+				if err != nil {
+					errs <- err
+					return
+				}
+				pk, err := schnorr.ParsePubKey(fpPk)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if !sig.Verify(msg, pk) {
+					errs <- errors.New("invalid schnorr signature")
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				if _, err := lm.SignEOTS(fpPk, chainID, msg, height, passphrase); err != nil {
+					errs <- err
+				}
+			}()
+			// requests with a wrong passphrase fail without affecting the others
+			go func() {
+				defer wg.Done()
+				if _, err := lm.SignSchnorrSig(fpPk, msg, "wrong-"+passphrase); err == nil {
+					errs <- errors.New("signed with a wrong passphrase")
+				}
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			require.NoError(t, err)
+		}
+	})
+}
+
+func FuzzSignEOTSBatch(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		fpName := testutil.GenRandomHexStr(r, 4)
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+		dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			dbBackend.Close()
+			err := os.RemoveAll(homeDir)
+			require.NoError(t, err)
+		}()
+		lm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+
+		fpPk, err := lm.CreateKey(fpName, passphrase, hdPath)
+		require.NoError(t, err)
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		startHeight := datagen.RandomInt(r, 100)
+		numMsgs := r.Intn(10) + 2
+		msgs := make([]*types.HeightMsg, 0, numMsgs)
+		for i := 0; i < numMsgs; i++ {
+			msgs = append(msgs, &types.HeightMsg{
+				Height: startHeight + uint64(i),
+				Msg:    datagen.GenRandomByteArray(r, 32),
+			})
 		}
+
+		sigs, err := lm.SignEOTSBatch(fpPk, chainID, msgs, passphrase)
+		require.NoError(t, err)
+		require.Len(t, sigs, numMsgs)
+		for i, m := range msgs {
+			sig, err := lm.SignEOTS(fpPk, chainID, m.Msg, m.Height, passphrase)
+			require.NoError(t, err)
+			require.True(t, sig.Equals(sigs[i]))
+		}
+
+		// signing the same batch again returns the same signatures
+		sigs2, err := lm.SignEOTSBatch(fpPk, chainID, msgs, passphrase)
+		require.NoError(t, err)
+		for i := range sigs {
+			require.True(t, sigs[i].Equals(sigs2[i]))
+		}
+
+		// a batch including a conflicting message is refused as a whole
+		newHeight := startHeight + uint64(numMsgs)
+		conflicting := []*types.HeightMsg{
+			{Height: newHeight, Msg: datagen.GenRandomByteArray(r, 32)},
+			{Height: msgs[r.Intn(numMsgs)].Height, Msg: datagen.GenRandomByteArray(r, 32)},
+		}
+		_, err = lm.SignEOTSBatch(fpPk, chainID, conflicting, passphrase)
+		require.ErrorIs(t, err, types.ErrDoubleSign)
+
+		// conflicting messages within the batch are refused as well
+		_, err = lm.SignEOTSBatch(fpPk, chainID, []*types.HeightMsg{
+			{Height: newHeight, Msg: datagen.GenRandomByteArray(r, 32)},
+			{Height: newHeight, Msg: datagen.GenRandomByteArray(r, 32)},
+		}, passphrase)
+		require.ErrorIs(t, err, types.ErrDoubleSign)
+
+		// nothing of the refused batches is recorded
+		_, err = lm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), newHeight, passphrase)
+		require.NoError(t, err)
 	})
 }
@@ -19,16 +19,34 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type EOTSManagerClient interface {
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	// GetInfo returns the version and the status of the EOTS manager
+	GetInfo(ctx context.Context, in *EOTSManagerInfoRequest, opts ...grpc.CallOption) (*EOTSManagerInfoResponse, error)
 	// CreateKey generates and saves an EOTS key
 	CreateKey(ctx context.Context, in *CreateKeyRequest, opts ...grpc.CallOption) (*CreateKeyResponse, error)
 	// CreateRandomnessPairList returns a list of Schnorr randomness pairs
 	CreateRandomnessPairList(ctx context.Context, in *CreateRandomnessPairListRequest, opts ...grpc.CallOption) (*CreateRandomnessPairListResponse, error)
-	// KeyRecord returns the key record
+	// KeyRecord returns the key record including the private key
+	// NOTE: it is disabled unless the server is started with --unsafe-allow-key-export
 	KeyRecord(ctx context.Context, in *KeyRecordRequest, opts ...grpc.CallOption) (*KeyRecordResponse, error)
+	// PubKeyRecord returns the name and the public key of an EOTS key
+	PubKeyRecord(ctx context.Context, in *PubKeyRecordRequest, opts ...grpc.CallOption) (*PubKeyRecordResponse, error)
+	// ListKeys returns the names and the public keys of all the EOTS keys
+	ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysResponse, error)
+	// ImportKey imports an EOTS key from a mnemonic or an armored private key
+	ImportKey(ctx context.Context, in *ImportKeyRequest, opts ...grpc.CallOption) (*ImportKeyResponse, error)
+	// DeleteKey deletes an EOTS key, which can never be added back with another name
+	DeleteKey(ctx context.Context, in *DeleteKeyRequest, opts ...grpc.CallOption) (*DeleteKeyResponse, error)
 	// SignEOTS signs an EOTS with the EOTS private key and the relevant randomness
 	SignEOTS(ctx context.Context, in *SignEOTSRequest, opts ...grpc.CallOption) (*SignEOTSResponse, error)
+	// SignEOTSBatch signs a batch of EOTS of the same key and chain at different heights
+	SignEOTSBatch(ctx context.Context, in *SignEOTSBatchRequest, opts ...grpc.CallOption) (*SignEOTSBatchResponse, error)
+	// UnsafeSignEOTS signs an EOTS without double-sign protection
+	// NOTE: it is disabled unless the server is started with --unsafe-allow-double-sign
+	UnsafeSignEOTS(ctx context.Context, in *SignEOTSRequest, opts ...grpc.CallOption) (*SignEOTSResponse, error)
 	// SignSchnorrSig signs a Schnorr sig with the EOTS private key
 	SignSchnorrSig(ctx context.Context, in *SignSchnorrSigRequest, opts ...grpc.CallOption) (*SignSchnorrSigResponse, error)
+	// QueryAuditLog returns the entries of the signing audit log
+	QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
 }
 
 type eOTSManagerClient struct {
@@ -48,6 +66,15 @@ func (c *eOTSManagerClient) Ping(ctx context.Context, in *PingRequest, opts ...g
 	return out, nil
 }
 
+func (c *eOTSManagerClient) GetInfo(ctx context.Context, in *EOTSManagerInfoRequest, opts ...grpc.CallOption) (*EOTSManagerInfoResponse, error) {
+	out := new(EOTSManagerInfoResponse)
+	err := c.cc.Invoke(ctx, "/proto.EOTSManager/GetInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eOTSManagerClient) CreateKey(ctx context.Context, in *CreateKeyRequest, opts ...grpc.CallOption) (*CreateKeyResponse, error) {
 	out := new(CreateKeyResponse)
 	err := c.cc.Invoke(ctx, "/proto.EOTSManager/CreateKey", in, out, opts...)
@@ -75,6 +102,42 @@ func (c *eOTSManagerClient) KeyRecord(ctx context.Context, in *KeyRecordRequest,
 	return out, nil
 }
 
+func (c *eOTSManagerClient) PubKeyRecord(ctx context.Context, in *PubKeyRecordRequest, opts ...grpc.CallOption) (*PubKeyRecordResponse, error) {
+	out := new(PubKeyRecordResponse)
+	err := c.cc.Invoke(ctx, "/proto.EOTSManager/PubKeyRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSManagerClient) ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysResponse, error) {
+	out := new(ListKeysResponse)
+	err := c.cc.Invoke(ctx, "/proto.EOTSManager/ListKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSManagerClient) ImportKey(ctx context.Context, in *ImportKeyRequest, opts ...grpc.CallOption) (*ImportKeyResponse, error) {
+	out := new(ImportKeyResponse)
+	err := c.cc.Invoke(ctx, "/proto.EOTSManager/ImportKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSManagerClient) DeleteKey(ctx context.Context, in *DeleteKeyRequest, opts ...grpc.CallOption) (*DeleteKeyResponse, error) {
+	out := new(DeleteKeyResponse)
+	err := c.cc.Invoke(ctx, "/proto.EOTSManager/DeleteKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eOTSManagerClient) SignEOTS(ctx context.Context, in *SignEOTSRequest, opts ...grpc.CallOption) (*SignEOTSResponse, error) {
 	out := new(SignEOTSResponse)
 	err := c.cc.Invoke(ctx, "/proto.EOTSManager/SignEOTS", in, out, opts...)
@@ -84,6 +147,24 @@ func (c *eOTSManagerClient) SignEOTS(ctx context.Context, in *SignEOTSRequest, o
 	return out, nil
 }
 
+func (c *eOTSManagerClient) SignEOTSBatch(ctx context.Context, in *SignEOTSBatchRequest, opts ...grpc.CallOption) (*SignEOTSBatchResponse, error) {
+	out := new(SignEOTSBatchResponse)
+	err := c.cc.Invoke(ctx, "/proto.EOTSManager/SignEOTSBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSManagerClient) UnsafeSignEOTS(ctx context.Context, in *SignEOTSRequest, opts ...grpc.CallOption) (*SignEOTSResponse, error) {
+	out := new(SignEOTSResponse)
+	err := c.cc.Invoke(ctx, "/proto.EOTSManager/UnsafeSignEOTS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eOTSManagerClient) SignSchnorrSig(ctx context.Context, in *SignSchnorrSigRequest, opts ...grpc.CallOption) (*SignSchnorrSigResponse, error) {
 	out := new(SignSchnorrSigResponse)
 	err := c.cc.Invoke(ctx, "/proto.EOTSManager/SignSchnorrSig", in, out, opts...)
@@ -93,21 +174,48 @@ func (c *eOTSManagerClient) SignSchnorrSig(ctx context.Context, in *SignSchnorrS
 	return out, nil
 }
 
+func (c *eOTSManagerClient) QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/proto.EOTSManager/QueryAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EOTSManagerServer is the server API for EOTSManager service.
 // All implementations must embed UnimplementedEOTSManagerServer
 // for forward compatibility
 type EOTSManagerServer interface {
 	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	// GetInfo returns the version and the status of the EOTS manager
+	GetInfo(context.Context, *EOTSManagerInfoRequest) (*EOTSManagerInfoResponse, error)
 	// CreateKey generates and saves an EOTS key
 	CreateKey(context.Context, *CreateKeyRequest) (*CreateKeyResponse, error)
 	// CreateRandomnessPairList returns a list of Schnorr randomness pairs
 	CreateRandomnessPairList(context.Context, *CreateRandomnessPairListRequest) (*CreateRandomnessPairListResponse, error)
-	// KeyRecord returns the key record
+	// KeyRecord returns the key record including the private key
+	// NOTE: it is disabled unless the server is started with --unsafe-allow-key-export
 	KeyRecord(context.Context, *KeyRecordRequest) (*KeyRecordResponse, error)
+	// PubKeyRecord returns the name and the public key of an EOTS key
+	PubKeyRecord(context.Context, *PubKeyRecordRequest) (*PubKeyRecordResponse, error)
+	// ListKeys returns the names and the public keys of all the EOTS keys
+	ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error)
+	// ImportKey imports an EOTS key from a mnemonic or an armored private key
+	ImportKey(context.Context, *ImportKeyRequest) (*ImportKeyResponse, error)
+	// DeleteKey deletes an EOTS key, which can never be added back with another name
+	DeleteKey(context.Context, *DeleteKeyRequest) (*DeleteKeyResponse, error)
 	// SignEOTS signs an EOTS with the EOTS private key and the relevant randomness
 	SignEOTS(context.Context, *SignEOTSRequest) (*SignEOTSResponse, error)
+	// SignEOTSBatch signs a batch of EOTS of the same key and chain at different heights
+	SignEOTSBatch(context.Context, *SignEOTSBatchRequest) (*SignEOTSBatchResponse, error)
+	// UnsafeSignEOTS signs an EOTS without double-sign protection
+	// NOTE: it is disabled unless the server is started with --unsafe-allow-double-sign
+	UnsafeSignEOTS(context.Context, *SignEOTSRequest) (*SignEOTSResponse, error)
 	// SignSchnorrSig signs a Schnorr sig with the EOTS private key
 	SignSchnorrSig(context.Context, *SignSchnorrSigRequest) (*SignSchnorrSigResponse, error)
+	// QueryAuditLog returns the entries of the signing audit log
+	QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
 	mustEmbedUnimplementedEOTSManagerServer()
 }
 
@@ -118,6 +226,9 @@ type UnimplementedEOTSManagerServer struct {
 func (UnimplementedEOTSManagerServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
 }
+func (UnimplementedEOTSManagerServer) GetInfo(context.Context, *EOTSManagerInfoRequest) (*EOTSManagerInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+}
 func (UnimplementedEOTSManagerServer) CreateKey(context.Context, *CreateKeyRequest) (*CreateKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateKey not implemented")
 }
@@ -127,12 +238,33 @@ func (UnimplementedEOTSManagerServer) CreateRandomnessPairList(context.Context,
 func (UnimplementedEOTSManagerServer) KeyRecord(context.Context, *KeyRecordRequest) (*KeyRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method KeyRecord not implemented")
 }
+func (UnimplementedEOTSManagerServer) PubKeyRecord(context.Context, *PubKeyRecordRequest) (*PubKeyRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKeyRecord not implemented")
+}
+func (UnimplementedEOTSManagerServer) ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKeys not implemented")
+}
+func (UnimplementedEOTSManagerServer) ImportKey(context.Context, *ImportKeyRequest) (*ImportKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportKey not implemented")
+}
+func (UnimplementedEOTSManagerServer) DeleteKey(context.Context, *DeleteKeyRequest) (*DeleteKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKey not implemented")
+}
 func (UnimplementedEOTSManagerServer) SignEOTS(context.Context, *SignEOTSRequest) (*SignEOTSResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignEOTS not implemented")
 }
+func (UnimplementedEOTSManagerServer) SignEOTSBatch(context.Context, *SignEOTSBatchRequest) (*SignEOTSBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignEOTSBatch not implemented")
+}
+func (UnimplementedEOTSManagerServer) UnsafeSignEOTS(context.Context, *SignEOTSRequest) (*SignEOTSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsafeSignEOTS not implemented")
+}
 func (UnimplementedEOTSManagerServer) SignSchnorrSig(context.Context, *SignSchnorrSigRequest) (*SignSchnorrSigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignSchnorrSig not implemented")
 }
+func (UnimplementedEOTSManagerServer) QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
 func (UnimplementedEOTSManagerServer) mustEmbedUnimplementedEOTSManagerServer() {}
 
 // UnsafeEOTSManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +296,24 @@ func _EOTSManager_Ping_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EOTSManager_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EOTSManagerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EOTSManager/GetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).GetInfo(ctx, req.(*EOTSManagerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EOTSManager_CreateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateKeyRequest)
 	if err := dec(in); err != nil {
@@ -218,6 +368,78 @@ func _EOTSManager_KeyRecord_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EOTSManager_PubKeyRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PubKeyRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).PubKeyRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EOTSManager/PubKeyRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).PubKeyRecord(ctx, req.(*PubKeyRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSManager_ListKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).ListKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EOTSManager/ListKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).ListKeys(ctx, req.(*ListKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSManager_ImportKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).ImportKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EOTSManager/ImportKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).ImportKey(ctx, req.(*ImportKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSManager_DeleteKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).DeleteKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EOTSManager/DeleteKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).DeleteKey(ctx, req.(*DeleteKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EOTSManager_SignEOTS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SignEOTSRequest)
 	if err := dec(in); err != nil {
@@ -236,6 +458,42 @@ func _EOTSManager_SignEOTS_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EOTSManager_SignEOTSBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignEOTSBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).SignEOTSBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EOTSManager/SignEOTSBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).SignEOTSBatch(ctx, req.(*SignEOTSBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSManager_UnsafeSignEOTS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignEOTSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).UnsafeSignEOTS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EOTSManager/UnsafeSignEOTS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).UnsafeSignEOTS(ctx, req.(*SignEOTSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EOTSManager_SignSchnorrSig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SignSchnorrSigRequest)
 	if err := dec(in); err != nil {
@@ -254,6 +512,24 @@ func _EOTSManager_SignSchnorrSig_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EOTSManager_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EOTSManager/QueryAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).QueryAuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EOTSManager_ServiceDesc is the grpc.ServiceDesc for EOTSManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -265,6 +541,10 @@ var EOTSManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Ping",
 			Handler:    _EOTSManager_Ping_Handler,
 		},
+		{
+			MethodName: "GetInfo",
+			Handler:    _EOTSManager_GetInfo_Handler,
+		},
 		{
 			MethodName: "CreateKey",
 			Handler:    _EOTSManager_CreateKey_Handler,
@@ -277,14 +557,42 @@ var EOTSManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "KeyRecord",
 			Handler:    _EOTSManager_KeyRecord_Handler,
 		},
+		{
+			MethodName: "PubKeyRecord",
+			Handler:    _EOTSManager_PubKeyRecord_Handler,
+		},
+		{
+			MethodName: "ListKeys",
+			Handler:    _EOTSManager_ListKeys_Handler,
+		},
+		{
+			MethodName: "ImportKey",
+			Handler:    _EOTSManager_ImportKey_Handler,
+		},
+		{
+			MethodName: "DeleteKey",
+			Handler:    _EOTSManager_DeleteKey_Handler,
+		},
 		{
 			MethodName: "SignEOTS",
 			Handler:    _EOTSManager_SignEOTS_Handler,
 		},
+		{
+			MethodName: "SignEOTSBatch",
+			Handler:    _EOTSManager_SignEOTSBatch_Handler,
+		},
+		{
+			MethodName: "UnsafeSignEOTS",
+			Handler:    _EOTSManager_UnsafeSignEOTS_Handler,
+		},
 		{
 			MethodName: "SignSchnorrSig",
 			Handler:    _EOTSManager_SignSchnorrSig_Handler,
 		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _EOTSManager_QueryAuditLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eotsmanager.proto",
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/proto"
+)
+
+// unknownCaller is the caller of the requests which are neither identified
+// by the signing authorization policy nor a verified TLS certificate
+const unknownCaller = "unknown"
+
+// recordAudit appends the entry of a served request to the audit log.
+// Failing to record the entry is logged but does not fail the request,
+// which has already been served
+func (r *rpcServer) recordAudit(ctx context.Context, entry *proto.AuditEntry, err error) {
+	r.recordAuditEntries(ctx, []*proto.AuditEntry{entry}, err)
+}
+
+// recordAuditEntries appends the entries of a served request, e.g., one per
+// message of a batch, to the audit log in a single transaction
+func (r *rpcServer) recordAuditEntries(ctx context.Context, entries []*proto.AuditEntry, err error) {
+	if r.audit == nil || len(entries) == 0 {
+		return
+	}
+
+	timestamp := time.Now().UnixNano()
+	caller, peerAddr := r.callerIdentity(ctx)
+	for _, entry := range entries {
+		entry.Timestamp = timestamp
+		entry.Caller, entry.PeerAddr = caller, peerAddr
+		if err != nil {
+			entry.Error = err.Error()
+		}
+	}
+
+	if appendErr := r.audit.AppendEntries(entries); appendErr != nil {
+		r.logger.Error("failed to record the audit entries",
+			zap.String("method", entries[0].Method),
+			zap.String("eots_pk", hex.EncodeToString(entries[0].EotsPk)),
+			zap.Uint64("height", entries[0].Height),
+			zap.Int("num_entries", len(entries)),
+			zap.Error(appendErr))
+	}
+}
+
+// callerIdentity returns the name of the client of the request and its address.
+// The client is named by the signing authorization policy if it is identified,
+// or by the subject of its verified TLS certificate otherwise
+func (r *rpcServer) callerIdentity(ctx context.Context) (string, string) {
+	caller := unknownCaller
+	if r.policy != nil {
+		if client, err := r.policy.identify(ctx); err == nil {
+			caller = client.Name
+		}
+	}
+
+	var peerAddr string
+	if p, ok := peer.FromContext(ctx); ok {
+		if p.Addr != nil {
+			peerAddr = p.Addr.String()
+		}
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && caller == unknownCaller &&
+			len(tlsInfo.State.VerifiedChains) > 0 {
+			caller = tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+		}
+	}
+
+	return caller, peerAddr
+}
+
+func msgHash(msg []byte) []byte {
+	h := sha256.Sum256(msg)
+	return h[:]
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/proto"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/store"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+func FuzzAuditLog(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 5)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		cfg := config.DefaultConfigWithHomePath(homeDir)
+		dbBackend, err := cfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer dbBackend.Close()
+
+		em, err := eotsmanager.NewLocalEOTSManager(homeDir, cfg.KeyringBackend, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+		auditStore, err := store.NewAuditStore(dbBackend)
+		require.NoError(t, err)
+
+		rpc := newRPCServer(em, zap.NewNop(), false, false, cfg.KeyringBackend)
+		rpc.audit = auditStore
+
+		createRes, err := rpc.CreateKey(context.Background(), &proto.CreateKeyRequest{
+			Name: testutil.GenRandomHexStr(r, 4),
+		})
+		require.NoError(t, err)
+		pkHex := hex.EncodeToString(createRes.Pk)
+
+		token1 := testutil.GenRandomHexStr(r, 16)
+		token2 := testutil.GenRandomHexStr(r, 16)
+		chainID := []byte(testutil.GenRandomHexStr(r, 10))
+		rpc.policy = newPolicyEnforcer(&Policy{Clients: []*ClientPolicy{
+			{
+				Name:            "fp-1",
+				APIToken:        token1,
+				AllowedEOTSPks:  []string{pkHex},
+				AllowedChainIDs: []string{string(chainID)},
+			},
+			{
+				Name:            "fp-2",
+				APIToken:        token2,
+				AllowedEOTSPks:  []string{randomPkHex(t, r)},
+				AllowedChainIDs: []string{string(chainID)},
+			},
+		}})
+		ctx1 := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, bearerPrefix+token1))
+		ctx2 := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, bearerPrefix+token2))
+
+		height := datagen.RandomInt(r, 1000) + 1
+		msg := datagen.GenRandomByteArray(r, 32)
+		_, err = rpc.CreateRandomnessPairList(ctx1, &proto.CreateRandomnessPairListRequest{
+			Uid: createRes.Pk, ChainId: chainID, StartHeight: height, Num: 10,
+		})
+		require.NoError(t, err)
+		_, err = rpc.SignEOTS(ctx1, &proto.SignEOTSRequest{
+			Uid: createRes.Pk, ChainId: chainID, Msg: msg, Height: height,
+		})
+		require.NoError(t, err)
+		// the refused request of the other client is recorded as well
+		_, err = rpc.SignEOTS(ctx2, &proto.SignEOTSRequest{
+			Uid: createRes.Pk, ChainId: chainID, Msg: msg, Height: height + 1,
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		entries, err := auditStore.QueryEntries(&store.AuditFilter{})
+		require.NoError(t, err)
+		require.Len(t, entries, 4)
+		require.Equal(t, "CreateKey", entries[0].Method)
+		require.Equal(t, createRes.Pk, entries[0].EotsPk)
+		require.Equal(t, unknownCaller, entries[0].Caller)
+		require.Equal(t, "CreateRandomnessPairList", entries[1].Method)
+		require.Equal(t, "fp-1", entries[1].Caller)
+		require.Equal(t, height, entries[1].Height)
+		require.Equal(t, uint32(10), entries[1].Num)
+		require.Equal(t, "SignEOTS", entries[2].Method)
+		require.Equal(t, msgHash(msg), entries[2].MsgHash)
+		require.Empty(t, entries[2].Error)
+		require.Equal(t, "fp-2", entries[3].Caller)
+		require.NotEmpty(t, entries[3].Error)
+
+		// the clients only see the entries of their own keys
+		res, err := rpc.QueryAuditLog(ctx1, &proto.QueryAuditLogRequest{FromHeight: height, ToHeight: height})
+		require.NoError(t, err)
+		require.Len(t, res.Entries, 2)
+		res, err = rpc.QueryAuditLog(ctx2, &proto.QueryAuditLogRequest{})
+		require.NoError(t, err)
+		require.Empty(t, res.Entries)
+		_, err = rpc.QueryAuditLog(context.Background(), &proto.QueryAuditLogRequest{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// authorizationHeader is the gRPC metadata key carrying the API token
+	// of the client in the form of "Bearer <token>"
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// Policy is the signing authorization policy of the EOTS manager server.
+// Each client is identified by either the subject common name of its TLS
+// certificate or an API token, and is only allowed to use the listed EOTS
+// keys for the listed chains
+type Policy struct {
+	Clients []*ClientPolicy `json:"clients"`
+}
+
+// ClientPolicy is the signing authorization policy of a single client
+type ClientPolicy struct {
+	// Name identifies the client in the logs
+	Name string `json:"name"`
+	// TLSSubject is the subject common name of the client TLS certificate
+	TLSSubject string `json:"tls_subject,omitempty"`
+	// APIToken is the token sent by the client in the authorization header
+	APIToken string `json:"api_token,omitempty"`
+	// AllowedEOTSPks are the hex encoded EOTS public keys the client may use
+	AllowedEOTSPks []string `json:"allowed_eots_pks"`
+	// AllowedChainIDs are the chain IDs the client may sign or create randomness for
+	AllowedChainIDs []string `json:"allowed_chain_ids"`
+	// MaxHeightJump is the maximum number of heights a request may go beyond the
+	// highest height previously requested by the client for the same key and chain.
+	// It is not limited if it is 0
+	MaxHeightJump uint64 `json:"max_height_jump,omitempty"`
+}
+
+// LoadPolicy loads and validates the signing authorization policy from the given file
+func LoadPolicy(policyFile string) (*Policy, error) {
+	policyBytes, err := os.ReadFile(policyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the policy file %s: %w", policyFile, err)
+	}
+
+	var policy Policy
+	if err := json.Unmarshal(policyBytes, &policy); err != nil {
+		return nil, fmt.Errorf("failed to decode the policy file %s: %w", policyFile, err)
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid policy file %s: %w", policyFile, err)
+	}
+
+	return &policy, nil
+}
+
+func (p *Policy) Validate() error {
+	names := make(map[string]struct{})
+	for _, c := range p.Clients {
+		if c.Name == "" {
+			return fmt.Errorf("the client name should not be empty")
+		}
+		if _, ok := names[c.Name]; ok {
+			return fmt.Errorf("duplicate client name %s", c.Name)
+		}
+		names[c.Name] = struct{}{}
+
+		if c.TLSSubject == "" && c.APIToken == "" {
+			return fmt.Errorf("client %s should be identified by either a TLS subject or an API token", c.Name)
+		}
+		if len(c.AllowedEOTSPks) == 0 {
+			return fmt.Errorf("client %s is not allowed to use any EOTS key", c.Name)
+		}
+		for _, pkHex := range c.AllowedEOTSPks {
+			if _, err := bbntypes.NewBIP340PubKeyFromHex(pkHex); err != nil {
+				return fmt.Errorf("invalid EOTS public key %s of client %s: %w", pkHex, c.Name, err)
+			}
+		}
+		if len(c.AllowedChainIDs) == 0 {
+			return fmt.Errorf("client %s is not allowed to use any chain", c.Name)
+		}
+	}
+
+	return nil
+}
+
+// policyEnforcer authorizes the requests of the clients against the policy
+type policyEnforcer struct {
+	policy *Policy
+
+	mu sync.Mutex
+	// highestHeights tracks the highest height requested by each client
+	// for each key and chain, which is used to enforce MaxHeightJump
+	highestHeights map[string]uint64
+}
+
+func newPolicyEnforcer(policy *Policy) *policyEnforcer {
+	return &policyEnforcer{
+		policy:         policy,
+		highestHeights: make(map[string]uint64),
+	}
+}
+
+// authorizeKey authorizes the client of the request to use the given EOTS key
+func (pe *policyEnforcer) authorizeKey(ctx context.Context, uid []byte) (*ClientPolicy, error) {
+	client, err := pe.identify(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pkHex := hex.EncodeToString(uid)
+	if !containsFold(client.AllowedEOTSPks, pkHex) {
+		return nil, status.Errorf(codes.PermissionDenied,
+			"client %s is not allowed to use EOTS key %s", client.Name, pkHex)
+	}
+
+	return client, nil
+}
+
+// authorizeHeight authorizes the client of the request to use the given EOTS key
+// for the given chain up to the given height
+func (pe *policyEnforcer) authorizeHeight(ctx context.Context, uid []byte, chainID []byte, height uint64) error {
+	client, err := pe.authorizeKey(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(client.AllowedChainIDs, string(chainID)) {
+		return status.Errorf(codes.PermissionDenied,
+			"client %s is not allowed to use chain %s", client.Name, chainID)
+	}
+
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+
+	key := fmt.Sprintf("%s/%x/%s", client.Name, uid, chainID)
+	highest, ok := pe.highestHeights[key]
+	if ok && client.MaxHeightJump > 0 && height > highest && height-highest > client.MaxHeightJump {
+		return status.Errorf(codes.PermissionDenied,
+			"client %s requested height %d which exceeds the maximum height jump %d from height %d",
+			client.Name, height, client.MaxHeightJump, highest)
+	}
+	if !ok || height > highest {
+		pe.highestHeights[key] = height
+	}
+
+	return nil
+}
+
+// identify finds the client policy of the request by the API token in the
+// metadata or the subject of the verified TLS client certificate
+func (pe *policyEnforcer) identify(ctx context.Context) (*ClientPolicy, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, v := range md.Get(authorizationHeader) {
+			token, found := strings.CutPrefix(v, bearerPrefix)
+			if !found {
+				continue
+			}
+			for _, c := range pe.policy.Clients {
+				if c.APIToken != "" && subtle.ConstantTimeCompare([]byte(c.APIToken), []byte(token)) == 1 {
+					return c, nil
+				}
+			}
+			return nil, status.Error(codes.Unauthenticated, "unknown API token")
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) > 0 {
+			subject := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+			for _, c := range pe.policy.Clients {
+				if c.TLSSubject != "" && c.TLSSubject == subject {
+					return c, nil
+				}
+			}
+			return nil, status.Errorf(codes.Unauthenticated, "unknown TLS subject %s", subject)
+		}
+	}
+
+	return nil, status.Error(codes.Unauthenticated, "the client is neither identified by an API token nor a TLS certificate")
+}
+
+func containsFold(list []string, s string) bool {
+	for _, e := range list {
+		if strings.EqualFold(e, s) {
+			return true
+		}
+	}
+	return false
+}
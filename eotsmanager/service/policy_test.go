@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/json"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+func FuzzPolicyEnforcer(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		pk1 := randomPkHex(t, r)
+		pk2 := randomPkHex(t, r)
+		token := testutil.GenRandomHexStr(r, 16)
+		chainID := []byte(testutil.GenRandomHexStr(r, 10))
+		maxJump := uint64(r.Intn(100) + 1)
+
+		policy := &Policy{Clients: []*ClientPolicy{
+			{
+				Name:            "token-client",
+				APIToken:        token,
+				AllowedEOTSPks:  []string{pk1},
+				AllowedChainIDs: []string{string(chainID)},
+				MaxHeightJump:   maxJump,
+			},
+			{
+				Name:            "tls-client",
+				TLSSubject:      "fpd",
+				AllowedEOTSPks:  []string{pk2},
+				AllowedChainIDs: []string{string(chainID)},
+			},
+		}}
+		policyFile := filepath.Join(t.TempDir(), "policy.json")
+		policyBytes, err := json.Marshal(policy)
+		require.NoError(t, err)
+		err = os.WriteFile(policyFile, policyBytes, 0600)
+		require.NoError(t, err)
+
+		loaded, err := LoadPolicy(policyFile)
+		require.NoError(t, err)
+		pe := newPolicyEnforcer(loaded)
+
+		pk1Bytes, _ := hex.DecodeString(pk1)
+		pk2Bytes, _ := hex.DecodeString(pk2)
+
+		tokenCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, bearerPrefix+token))
+		tlsCtx := peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+				VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: "fpd"}}}},
+			}},
+		})
+
+		// unidentified clients are refused
+		_, err = pe.authorizeKey(context.Background(), pk1Bytes)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		wrongTokenCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, bearerPrefix+"wrong"))
+		_, err = pe.authorizeKey(wrongTokenCtx, pk1Bytes)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		// the clients can only use their own keys
+		_, err = pe.authorizeKey(tokenCtx, pk1Bytes)
+		require.NoError(t, err)
+		_, err = pe.authorizeKey(tokenCtx, pk2Bytes)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = pe.authorizeKey(tlsCtx, pk2Bytes)
+		require.NoError(t, err)
+		_, err = pe.authorizeKey(tlsCtx, pk1Bytes)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// the clients can only use the allowed chains
+		err = pe.authorizeHeight(tokenCtx, pk1Bytes, []byte("other-chain"), 1)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// the height cannot jump beyond the limit
+		height := datagen.RandomInt(r, 1000) + 1
+		err = pe.authorizeHeight(tokenCtx, pk1Bytes, chainID, height)
+		require.NoError(t, err)
+		err = pe.authorizeHeight(tokenCtx, pk1Bytes, chainID, height+maxJump)
+		require.NoError(t, err)
+		err = pe.authorizeHeight(tokenCtx, pk1Bytes, chainID, height+2*maxJump+1)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		// lower heights are not limited
+		err = pe.authorizeHeight(tokenCtx, pk1Bytes, chainID, height-1)
+		require.NoError(t, err)
+
+		// the height of a client without limit can jump
+		err = pe.authorizeHeight(tlsCtx, pk2Bytes, chainID, height)
+		require.NoError(t, err)
+		err = pe.authorizeHeight(tlsCtx, pk2Bytes, chainID, height+1000*maxJump)
+		require.NoError(t, err)
+	})
+}
+
+func randomPkHex(t *testing.T, r *rand.Rand) string {
+	_, pk, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	return bbntypes.NewBIP340PubKeyFromBTCPK(pk).MarshalHex()
+}
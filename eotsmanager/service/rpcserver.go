@@ -2,28 +2,81 @@ package service
 
 import (
 	"context"
+	"encoding/hex"
+	"time"
 
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
 	"github.com/babylonlabs-io/finality-provider/eotsmanager"
 	"github.com/babylonlabs-io/finality-provider/eotsmanager/proto"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/store"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+	"github.com/babylonlabs-io/finality-provider/version"
 )
 
+// keyRecordExporter is implemented by the EOTS managers which are able to
+// export the private key, i.e., the local EOTS manager
+type keyRecordExporter interface {
+	KeyRecord(uid []byte, passphrase string) (*types.KeyRecord, error)
+}
+
+// unsafeSigner is implemented by the EOTS managers which are able to sign
+// without the double-sign protection, i.e., the local EOTS manager
+type unsafeSigner interface {
+	UnsafeSignEOTS(uid []byte, chainID []byte, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error)
+}
+
+// keyManager is implemented by the EOTS managers which are able to manage
+// the keys in their keyring, i.e., the local EOTS manager
+type keyManager interface {
+	ListKeys() ([]*types.PubKeyRecord, error)
+	CreateKeyWithMnemonic(name, passphrase, hdPath, mnemonic string) (*bbntypes.BIP340PubKey, error)
+	ImportArmoredKey(name, armor, armorPassphrase, passphrase string) (*bbntypes.BIP340PubKey, error)
+	DeleteKey(uid []byte, passphrase string) error
+}
+
 // rpcServer is the main RPC server for the EOTS daemon that handles
 // gRPC incoming requests.
 type rpcServer struct {
 	proto.UnimplementedEOTSManagerServer
 
-	em eotsmanager.EOTSManager
+	em     eotsmanager.EOTSManager
+	logger *zap.Logger
+
+	allowKeyExport  bool
+	allowDoubleSign bool
+	keyringBackend  string
+	startTime       time.Time
+	// audit is nil if the signing audit log is disabled
+	audit *store.AuditStore
+	// policy is nil if no signing authorization policy is configured,
+	// in which case all the clients are allowed
+	policy *policyEnforcer
 }
 
 // newRPCServer creates a new RPC sever from the set of input dependencies.
 func newRPCServer(
 	em eotsmanager.EOTSManager,
+	logger *zap.Logger,
+	allowKeyExport bool,
+	allowDoubleSign bool,
+	keyringBackend string,
 ) *rpcServer {
 
 	return &rpcServer{
-		em: em,
+		em:              em,
+		logger:          logger,
+		allowKeyExport:  allowKeyExport,
+		allowDoubleSign: allowDoubleSign,
+		keyringBackend:  keyringBackend,
+		startTime:       time.Now(),
 	}
 }
 
@@ -39,9 +92,71 @@ func (r *rpcServer) Ping(ctx context.Context, req *proto.PingRequest) (*proto.Pi
 	return &proto.PingResponse{}, nil
 }
 
+// GetInfo returns the version and the status of the EOTS manager
+func (r *rpcServer) GetInfo(ctx context.Context, req *proto.EOTSManagerInfoRequest) (*proto.EOTSManagerInfoResponse, error) {
+	res := &proto.EOTSManagerInfoResponse{
+		Version:        version.Version(),
+		ApiVersion:     eotsmanager.APIVersion,
+		UptimeSeconds:  uint64(time.Since(r.startTime).Seconds()),
+		KeyringBackend: r.keyringBackend,
+	}
+
+	if _, ok := r.em.(keyManager); ok {
+		records, err := r.visibleKeys(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res.NumKeys = uint32(len(records))
+	}
+
+	return res, nil
+}
+
+// authorizeKey enforces the signing authorization policy for using the EOTS key
+func (r *rpcServer) authorizeKey(ctx context.Context, uid []byte) error {
+	if r.policy == nil {
+		return nil
+	}
+
+	if _, err := r.policy.authorizeKey(ctx, uid); err != nil {
+		r.logger.Warn("refused an unauthorized request",
+			zap.String("eots_pk", hex.EncodeToString(uid)), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// authorizeHeight enforces the signing authorization policy for using the EOTS key
+// for the chain up to the height
+func (r *rpcServer) authorizeHeight(ctx context.Context, uid []byte, chainID []byte, height uint64) error {
+	if r.policy == nil {
+		return nil
+	}
+
+	if err := r.policy.authorizeHeight(ctx, uid, chainID, height); err != nil {
+		r.logger.Warn("refused an unauthorized request",
+			zap.String("eots_pk", hex.EncodeToString(uid)),
+			zap.String("chain_id", string(chainID)),
+			zap.Uint64("height", height),
+			zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
 // CreateKey generates and saves an EOTS key
 func (r *rpcServer) CreateKey(ctx context.Context, req *proto.CreateKeyRequest) (
-	*proto.CreateKeyResponse, error) {
+	res *proto.CreateKeyResponse, err error) {
+
+	defer func() {
+		entry := &proto.AuditEntry{Method: "CreateKey"}
+		if res != nil {
+			entry.EotsPk = res.Pk
+		}
+		r.recordAudit(ctx, entry, err)
+	}()
 
 	pk, err := r.em.CreateKey(req.Name, req.Passphrase, req.HdPath)
 
@@ -54,7 +169,25 @@ func (r *rpcServer) CreateKey(ctx context.Context, req *proto.CreateKeyRequest)
 
 // CreateRandomnessPairList returns a list of Schnorr randomness pairs
 func (r *rpcServer) CreateRandomnessPairList(ctx context.Context, req *proto.CreateRandomnessPairListRequest) (
-	*proto.CreateRandomnessPairListResponse, error) {
+	res *proto.CreateRandomnessPairListResponse, err error) {
+
+	defer func() {
+		r.recordAudit(ctx, &proto.AuditEntry{
+			Method:  "CreateRandomnessPairList",
+			EotsPk:  req.Uid,
+			ChainId: req.ChainId,
+			Height:  req.StartHeight,
+			Num:     req.Num,
+		}, err)
+	}()
+
+	endHeight := req.StartHeight
+	if req.Num > 0 {
+		endHeight += uint64(req.Num) - 1
+	}
+	if err := r.authorizeHeight(ctx, req.Uid, req.ChainId, endHeight); err != nil {
+		return nil, err
+	}
 
 	pubRandList, err := r.em.CreateRandomnessPairList(req.Uid, req.ChainId, req.StartHeight, req.Num, req.Passphrase)
 
@@ -72,15 +205,42 @@ func (r *rpcServer) CreateRandomnessPairList(ctx context.Context, req *proto.Cre
 	}, nil
 }
 
-// KeyRecord returns the key record
+// KeyRecord returns the key record including the private key
+// NOTE: it is refused unless the server is started with --unsafe-allow-key-export,
+// and every export is logged for auditing
 func (r *rpcServer) KeyRecord(ctx context.Context, req *proto.KeyRecordRequest) (
 	*proto.KeyRecordResponse, error) {
 
-	record, err := r.em.KeyRecord(req.Uid, req.Passphrase)
+	peerAddr := "unknown"
+	if p, ok := peer.FromContext(ctx); ok {
+		peerAddr = p.Addr.String()
+	}
+
+	if !r.allowKeyExport {
+		r.logger.Warn("refused to export the EOTS private key as key export is disabled",
+			zap.String("eots_pk", hex.EncodeToString(req.Uid)),
+			zap.String("peer", peerAddr),
+		)
+		return nil, status.Error(codes.PermissionDenied,
+			"exporting private keys is disabled, start the server with --unsafe-allow-key-export to enable it")
+	}
+
+	exporter, ok := r.em.(keyRecordExporter)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the EOTS manager does not support exporting private keys")
+	}
+
+	record, err := exporter.KeyRecord(req.Uid, req.Passphrase)
 	if err != nil {
 		return nil, err
 	}
 
+	r.logger.Warn("exported the EOTS private key",
+		zap.String("eots_pk", hex.EncodeToString(req.Uid)),
+		zap.String("key_name", record.Name),
+		zap.String("peer", peerAddr),
+	)
+
 	res := &proto.KeyRecordResponse{
 		Name:       record.Name,
 		PrivateKey: record.PrivKey.Serialize(),
@@ -89,9 +249,167 @@ func (r *rpcServer) KeyRecord(ctx context.Context, req *proto.KeyRecordRequest)
 	return res, nil
 }
 
+// PubKeyRecord returns the name and the public key of an EOTS key
+func (r *rpcServer) PubKeyRecord(ctx context.Context, req *proto.PubKeyRecordRequest) (
+	*proto.PubKeyRecordResponse, error) {
+
+	record, err := r.em.PubKeyRecord(req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.PubKeyRecordResponse{
+		Name: record.Name,
+		Pk:   schnorr.SerializePubKey(record.PubKey),
+	}, nil
+}
+
+func (r *rpcServer) keyManager() (keyManager, error) {
+	km, ok := r.em.(keyManager)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the EOTS manager does not support managing keys")
+	}
+
+	return km, nil
+}
+
+// ListKeys returns the names and the public keys of all the EOTS keys
+// If a signing authorization policy is configured, only the keys that the
+// client is allowed to use are returned
+func (r *rpcServer) ListKeys(ctx context.Context, req *proto.ListKeysRequest) (
+	*proto.ListKeysResponse, error) {
+
+	records, err := r.visibleKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*proto.PubKeyRecordResponse, 0, len(records))
+	for _, record := range records {
+		keys = append(keys, &proto.PubKeyRecordResponse{
+			Name: record.Name,
+			Pk:   schnorr.SerializePubKey(record.PubKey),
+		})
+	}
+
+	return &proto.ListKeysResponse{Keys: keys}, nil
+}
+
+// visibleKeys returns the EOTS keys that the client of the request is allowed to use
+func (r *rpcServer) visibleKeys(ctx context.Context) ([]*types.PubKeyRecord, error) {
+	km, err := r.keyManager()
+	if err != nil {
+		return nil, err
+	}
+
+	var client *ClientPolicy
+	if r.policy != nil {
+		client, err = r.policy.identify(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	records, err := km.ListKeys()
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return records, nil
+	}
+
+	allowed := make([]*types.PubKeyRecord, 0, len(records))
+	for _, record := range records {
+		pkHex := hex.EncodeToString(schnorr.SerializePubKey(record.PubKey))
+		if containsFold(client.AllowedEOTSPks, pkHex) {
+			allowed = append(allowed, record)
+		}
+	}
+
+	return allowed, nil
+}
+
+// ImportKey imports an EOTS key from a mnemonic or an armored private key
+// NOTE: only the identified clients can import keys if a policy is configured
+func (r *rpcServer) ImportKey(ctx context.Context, req *proto.ImportKeyRequest) (
+	res *proto.ImportKeyResponse, err error) {
+
+	defer func() {
+		entry := &proto.AuditEntry{Method: "ImportKey"}
+		if res != nil {
+			entry.EotsPk = res.Pk
+		}
+		r.recordAudit(ctx, entry, err)
+	}()
+
+	if r.policy != nil {
+		if _, err := r.policy.identify(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	km, err := r.keyManager()
+	if err != nil {
+		return nil, err
+	}
+
+	var eotsPk *bbntypes.BIP340PubKey
+	switch {
+	case req.Mnemonic != "" && req.ArmoredPrivKey != "":
+		return nil, status.Error(codes.InvalidArgument, "only one of the mnemonic and the armored private key should be set")
+	case req.Mnemonic != "":
+		eotsPk, err = km.CreateKeyWithMnemonic(req.Name, req.Passphrase, req.HdPath, req.Mnemonic)
+	case req.ArmoredPrivKey != "":
+		eotsPk, err = km.ImportArmoredKey(req.Name, req.ArmoredPrivKey, req.ArmorPassphrase, req.Passphrase)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either the mnemonic or the armored private key should be set")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ImportKeyResponse{Pk: eotsPk.MustMarshal()}, nil
+}
+
+// DeleteKey deletes an EOTS key, which can never be added back with another name
+func (r *rpcServer) DeleteKey(ctx context.Context, req *proto.DeleteKeyRequest) (
+	*proto.DeleteKeyResponse, error) {
+
+	if err := r.authorizeKey(ctx, req.Uid); err != nil {
+		return nil, err
+	}
+
+	km, err := r.keyManager()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := km.DeleteKey(req.Uid, req.Passphrase); err != nil {
+		return nil, err
+	}
+
+	r.logger.Info("deleted the EOTS key", zap.String("eots_pk", hex.EncodeToString(req.Uid)))
+
+	return &proto.DeleteKeyResponse{}, nil
+}
+
 // SignEOTS signs an EOTS with the EOTS private key and the relevant randomness
 func (r *rpcServer) SignEOTS(ctx context.Context, req *proto.SignEOTSRequest) (
-	*proto.SignEOTSResponse, error) {
+	res *proto.SignEOTSResponse, err error) {
+
+	defer func() {
+		r.recordAudit(ctx, &proto.AuditEntry{
+			Method:  "SignEOTS",
+			EotsPk:  req.Uid,
+			ChainId: req.ChainId,
+			Height:  req.Height,
+			MsgHash: msgHash(req.Msg),
+		}, err)
+	}()
+
+	if err := r.authorizeHeight(ctx, req.Uid, req.ChainId, req.Height); err != nil {
+		return nil, err
+	}
 
 	sig, err := r.em.SignEOTS(req.Uid, req.ChainId, req.Msg, req.Height, req.Passphrase)
 	if err != nil {
@@ -103,9 +421,110 @@ func (r *rpcServer) SignEOTS(ctx context.Context, req *proto.SignEOTSRequest) (
 	return &proto.SignEOTSResponse{Sig: sigBytes[:]}, nil
 }
 
+// SignEOTSBatch signs a batch of EOTS of the same key and chain at different heights
+func (r *rpcServer) SignEOTSBatch(ctx context.Context, req *proto.SignEOTSBatchRequest) (
+	res *proto.SignEOTSBatchResponse, err error) {
+
+	defer func() {
+		entries := make([]*proto.AuditEntry, 0, len(req.Msgs))
+		for _, m := range req.Msgs {
+			entries = append(entries, &proto.AuditEntry{
+				Method:  "SignEOTSBatch",
+				EotsPk:  req.Uid,
+				ChainId: req.ChainId,
+				Height:  m.Height,
+				MsgHash: msgHash(m.Msg),
+			})
+		}
+		r.recordAuditEntries(ctx, entries, err)
+	}()
+
+	msgs := make([]*types.HeightMsg, 0, len(req.Msgs))
+	for _, m := range req.Msgs {
+		if err := r.authorizeHeight(ctx, req.Uid, req.ChainId, m.Height); err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, &types.HeightMsg{Height: m.Height, Msg: m.Msg})
+	}
+
+	sigs, err := r.em.SignEOTSBatch(req.Uid, req.ChainId, msgs, req.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	sigsBytes := make([][]byte, 0, len(sigs))
+	for _, sig := range sigs {
+		sigBytes := sig.Bytes()
+		sigsBytes = append(sigsBytes, sigBytes[:])
+	}
+
+	return &proto.SignEOTSBatchResponse{Sigs: sigsBytes}, nil
+}
+
+// UnsafeSignEOTS signs an EOTS without double-sign protection
+// NOTE: it is refused unless the server is started with --unsafe-allow-double-sign,
+// as signing two messages at the same height leaks the private key
+func (r *rpcServer) UnsafeSignEOTS(ctx context.Context, req *proto.SignEOTSRequest) (
+	res *proto.SignEOTSResponse, err error) {
+
+	defer func() {
+		r.recordAudit(ctx, &proto.AuditEntry{
+			Method:  "UnsafeSignEOTS",
+			EotsPk:  req.Uid,
+			ChainId: req.ChainId,
+			Height:  req.Height,
+			MsgHash: msgHash(req.Msg),
+		}, err)
+	}()
+
+	if !r.allowDoubleSign {
+		peerAddr := "unknown"
+		if p, ok := peer.FromContext(ctx); ok {
+			peerAddr = p.Addr.String()
+		}
+		r.logger.Warn("refused to sign EOTS without double-sign protection as it is disabled",
+			zap.String("eots_pk", hex.EncodeToString(req.Uid)),
+			zap.Uint64("height", req.Height),
+			zap.String("peer", peerAddr),
+		)
+		return nil, status.Error(codes.PermissionDenied,
+			"signing without double-sign protection is disabled, start the server with --unsafe-allow-double-sign to enable it")
+	}
+
+	if err := r.authorizeHeight(ctx, req.Uid, req.ChainId, req.Height); err != nil {
+		return nil, err
+	}
+
+	signer, ok := r.em.(unsafeSigner)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the EOTS manager does not support signing without double-sign protection")
+	}
+
+	sig, err := signer.UnsafeSignEOTS(req.Uid, req.ChainId, req.Msg, req.Height, req.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	sigBytes := sig.Bytes()
+
+	return &proto.SignEOTSResponse{Sig: sigBytes[:]}, nil
+}
+
 // SignSchnorrSig signs a Schnorr sig with the EOTS private key
 func (r *rpcServer) SignSchnorrSig(ctx context.Context, req *proto.SignSchnorrSigRequest) (
-	*proto.SignSchnorrSigResponse, error) {
+	res *proto.SignSchnorrSigResponse, err error) {
+
+	defer func() {
+		r.recordAudit(ctx, &proto.AuditEntry{
+			Method:  "SignSchnorrSig",
+			EotsPk:  req.Uid,
+			MsgHash: msgHash(req.Msg),
+		}, err)
+	}()
+
+	if err := r.authorizeKey(ctx, req.Uid); err != nil {
+		return nil, err
+	}
 
 	sig, err := r.em.SignSchnorrSig(req.Uid, req.Msg, req.Passphrase)
 	if err != nil {
@@ -114,3 +533,59 @@ func (r *rpcServer) SignSchnorrSig(ctx context.Context, req *proto.SignSchnorrSi
 
 	return &proto.SignSchnorrSigResponse{Sig: sig.Serialize()}, nil
 }
+
+// QueryAuditLog returns the signing audit log entries matching the filter.
+// If a signing authorization policy is configured, only the entries of the
+// keys that the client is allowed to use are returned
+func (r *rpcServer) QueryAuditLog(ctx context.Context, req *proto.QueryAuditLogRequest) (
+	*proto.QueryAuditLogResponse, error) {
+
+	if r.audit == nil {
+		return nil, status.Error(codes.Unavailable, "the audit log is not available")
+	}
+
+	var client *ClientPolicy
+	if r.policy != nil {
+		var err error
+		client, err = r.policy.identify(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	filter := &store.AuditFilter{
+		EOTSPk:     req.EotsPk,
+		FromHeight: req.FromHeight,
+		ToHeight:   req.ToHeight,
+	}
+	if req.Since != 0 {
+		filter.Since = time.Unix(0, req.Since)
+	}
+	if req.Until != 0 {
+		filter.Until = time.Unix(0, req.Until)
+	}
+	if client == nil {
+		filter.Limit = req.Limit
+	}
+
+	entries, err := r.audit.QueryEntries(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if client != nil {
+		allowed := make([]*proto.AuditEntry, 0, len(entries))
+		for _, entry := range entries {
+			if !containsFold(client.AllowedEOTSPks, hex.EncodeToString(entry.EotsPk)) {
+				continue
+			}
+			allowed = append(allowed, entry)
+			if req.Limit > 0 && len(allowed) >= int(req.Limit) {
+				break
+			}
+		}
+		entries = allowed
+	}
+
+	return &proto.QueryAuditLogResponse{Entries: entries}, nil
+}
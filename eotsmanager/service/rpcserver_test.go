@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/proto"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/store"
+	"github.com/babylonlabs-io/finality-provider/version"
+)
+
+func TestGetInfo(t *testing.T) {
+	homeDir := filepath.Join(t.TempDir(), "eots-home")
+	cfg := config.DefaultConfigWithHomePath(homeDir)
+	dbBackend, err := cfg.DatabaseConfig.GetDbBackend()
+	require.NoError(t, err)
+	defer dbBackend.Close()
+
+	em, err := eotsmanager.NewLocalEOTSManager(homeDir, cfg.KeyringBackend, dbBackend, zap.NewNop())
+	require.NoError(t, err)
+	rpc := newRPCServer(em, zap.NewNop(), false, false, cfg.KeyringBackend)
+
+	pk1, err := em.CreateKey("key-1", "", "")
+	require.NoError(t, err)
+	_, err = em.CreateKey("key-2", "", "")
+	require.NoError(t, err)
+
+	info, err := rpc.GetInfo(context.Background(), &proto.EOTSManagerInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, version.Version(), info.Version)
+	require.Equal(t, eotsmanager.APIVersion, info.ApiVersion)
+	require.Equal(t, cfg.KeyringBackend, info.KeyringBackend)
+	require.Equal(t, uint32(2), info.NumKeys)
+
+	// only the keys allowed to the client are counted
+	token := "token"
+	rpc.policy = newPolicyEnforcer(&Policy{Clients: []*ClientPolicy{{
+		Name:            "fp",
+		APIToken:        token,
+		AllowedEOTSPks:  []string{hex.EncodeToString(pk1)},
+		AllowedChainIDs: []string{"chain"},
+	}}})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, bearerPrefix+token))
+	info, err = rpc.GetInfo(ctx, &proto.EOTSManagerInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), info.NumKeys)
+}
+
+func TestUnsafeSignEOTS(t *testing.T) {
+	homeDir := filepath.Join(t.TempDir(), "eots-home")
+	cfg := config.DefaultConfigWithHomePath(homeDir)
+	dbBackend, err := cfg.DatabaseConfig.GetDbBackend()
+	require.NoError(t, err)
+	defer dbBackend.Close()
+
+	em, err := eotsmanager.NewLocalEOTSManager(homeDir, cfg.KeyringBackend, dbBackend, zap.NewNop())
+	require.NoError(t, err)
+	pk, err := em.CreateKey("key", "", "")
+	require.NoError(t, err)
+
+	req := &proto.SignEOTSRequest{Uid: pk, ChainId: []byte("chain"), Msg: []byte("msg-1"), Height: 1}
+	_, err = em.SignEOTS(req.Uid, req.ChainId, req.Msg, req.Height, "")
+	require.NoError(t, err)
+	req.Msg = []byte("msg-2")
+
+	// signing a conflicting message is refused unless explicitly enabled
+	rpc := newRPCServer(em, zap.NewNop(), false, false, cfg.KeyringBackend)
+	_, err = rpc.UnsafeSignEOTS(context.Background(), req)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	rpc = newRPCServer(em, zap.NewNop(), false, true, cfg.KeyringBackend)
+	res, err := rpc.UnsafeSignEOTS(context.Background(), req)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Sig)
+}
+
+func TestImportKeyWithPolicy(t *testing.T) {
+	homeDir := filepath.Join(t.TempDir(), "eots-home")
+	cfg := config.DefaultConfigWithHomePath(homeDir)
+	dbBackend, err := cfg.DatabaseConfig.GetDbBackend()
+	require.NoError(t, err)
+	defer dbBackend.Close()
+
+	em, err := eotsmanager.NewLocalEOTSManager(homeDir, cfg.KeyringBackend, dbBackend, zap.NewNop())
+	require.NoError(t, err)
+	auditStore, err := store.NewAuditStore(dbBackend)
+	require.NoError(t, err)
+
+	token := "token"
+	rpc := newRPCServer(em, zap.NewNop(), false, false, cfg.KeyringBackend)
+	rpc.audit = auditStore
+	rpc.policy = newPolicyEnforcer(&Policy{Clients: []*ClientPolicy{{
+		Name:            "fp",
+		APIToken:        token,
+		AllowedEOTSPks:  []string{},
+		AllowedChainIDs: []string{"chain"},
+	}}})
+
+	mnemonic, err := eotsmanager.NewMnemonic()
+	require.NoError(t, err)
+	req := &proto.ImportKeyRequest{Name: "key", Mnemonic: mnemonic}
+
+	// the unidentified client cannot import keys
+	_, err = rpc.ImportKey(context.Background(), req)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, bearerPrefix+token))
+	res, err := rpc.ImportKey(ctx, req)
+	require.NoError(t, err)
+
+	// both of the attempts are audited
+	entries, err := auditStore.QueryEntries(&store.AuditFilter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "ImportKey", entries[0].Method)
+	require.Equal(t, "ImportKey", entries[1].Method)
+	require.NotEmpty(t, entries[0].Error)
+	require.Equal(t, res.Pk, entries[1].EotsPk)
+	require.Equal(t, "fp", entries[1].Caller)
+}
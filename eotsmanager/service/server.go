@@ -4,8 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"sync"
 	"sync/atomic"
+	"syscall"
+	"time"
 
 	"github.com/babylonlabs-io/finality-provider/metrics"
 
@@ -13,9 +17,31 @@ import (
 	"github.com/lightningnetwork/lnd/signal"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthgrpc "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
 
 	"github.com/babylonlabs-io/finality-provider/eotsmanager"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/client"
 	"github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/proto"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/store"
+	"github.com/babylonlabs-io/finality-provider/util"
+)
+
+const (
+	// auditLogPruneInterval is the interval of pruning the expired audit log entries
+	auditLogPruneInterval = time.Hour
+
+	// unixSocketPerm restricts the connections to the unix socket to the
+	// user running the EOTS manager
+	unixSocketPerm = 0600
+
+	// maxUnixSocketPathLen is the longest path a unix socket can be bound to,
+	// which is limited by sun_path (104 bytes on macOS, 108 bytes on Linux)
+	// including its terminating NUL
+	maxUnixSocketPathLen = len(syscall.RawSockaddrUnix{}.Path) - 1
 )
 
 // Server is the main daemon construct for the EOTS manager server. It handles
@@ -23,6 +49,8 @@ import (
 // EOTS manager server needs to function.
 type Server struct {
 	started int32
+	// ready is set once the RPC server is listening
+	ready int32
 
 	cfg    *config.Config
 	logger *zap.Logger
@@ -39,7 +67,7 @@ func NewEOTSManagerServer(cfg *config.Config, l *zap.Logger, em eotsmanager.EOTS
 	return &Server{
 		cfg:         cfg,
 		logger:      l,
-		rpcServer:   newRPCServer(em),
+		rpcServer:   newRPCServer(em, l, cfg.UnsafeAllowKeyExport, cfg.UnsafeAllowDoubleSign, cfg.KeyringBackend),
 		db:          db,
 		interceptor: sig,
 		quit:        make(chan struct{}, 1),
@@ -58,7 +86,7 @@ func (s *Server) RunUntilShutdown() error {
 	if err != nil {
 		return fmt.Errorf("failed to get prometheus address: %w", err)
 	}
-	metricsServer := metrics.Start(promAddr, s.logger)
+	metricsServer := metrics.Start(promAddr, s.logger, s.readinessChecks)
 
 	defer func() {
 		s.logger.Info("Shutdown complete")
@@ -72,27 +100,80 @@ func (s *Server) RunUntilShutdown() error {
 		s.logger.Info("Metrics server stopped")
 	}()
 
+	if s.cfg.UnsafeAllowKeyExport {
+		s.logger.Warn("exporting EOTS private keys over RPC is enabled, " +
+			"this is unsafe and should only be used for testing purposes")
+	}
+
+	if s.cfg.UnsafeAllowDoubleSign {
+		s.logger.Warn("signing EOTS without double-sign protection over RPC is enabled, " +
+			"this is unsafe and should only be used for testing purposes")
+	}
+
 	listenAddr := s.cfg.RpcListener
 	// we create listeners from the RPCListeners defined
 	// in the config.
-	lis, err := net.Listen("tcp", listenAddr)
+	lis, err := listen(listenAddr)
 	if err != nil {
 		return fmt.Errorf("failed to listen on %s: %w", listenAddr, err)
 	}
 	defer lis.Close()
 
-	grpcServer := grpc.NewServer()
+	if s.cfg.PolicyFile != "" {
+		policy, err := LoadPolicy(s.cfg.PolicyFile)
+		if err != nil {
+			return fmt.Errorf("failed to load the signing authorization policy: %w", err)
+		}
+		s.rpcServer.policy = newPolicyEnforcer(policy)
+		s.logger.Info("the signing authorization policy is enabled",
+			zap.String("policy_file", s.cfg.PolicyFile),
+			zap.Int("num_clients", len(policy.Clients)))
+	}
+
+	auditStore, err := store.NewAuditStore(s.db)
+	if err != nil {
+		return fmt.Errorf("failed to initiate the audit log: %w", err)
+	}
+	s.rpcServer.audit = auditStore
+	if s.cfg.AuditLogRetention > 0 {
+		go s.auditLogPruneLoop(auditStore)
+	}
+
+	serverOpts := []grpc.ServerOption{
+		// permit the keepalive pings of the clients on idle connections
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             client.MinKeepaliveTime,
+			PermitWithoutStream: true,
+		}),
+	}
+	if s.cfg.TLS.Enabled() {
+		tlsCfg, err := s.cfg.TLS.ServerTLSConfig()
+		if err != nil {
+			return fmt.Errorf("failed to load TLS config: %w", err)
+		}
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsCfg)))
+		s.logger.Info("TLS is enabled for the RPC server",
+			zap.Bool("client_auth", s.cfg.TLS.ClientCAFile != ""))
+	}
+
+	grpcServer := grpc.NewServer(serverOpts...)
 	defer grpcServer.Stop()
 
 	if err := s.rpcServer.RegisterWithGrpcServer(grpcServer); err != nil {
 		return fmt.Errorf("failed to register gRPC server: %w", err)
 	}
 
+	healthServer := health.NewServer()
+	healthgrpc.RegisterHealthServer(grpcServer, healthServer)
+	defer healthServer.Shutdown()
+
 	// All the necessary components have been registered, so we can
 	// actually start listening for requests.
 	if err := s.startGrpcListen(grpcServer, []net.Listener{lis}); err != nil {
 		return fmt.Errorf("failed to start gRPC listener: %v", err)
 	}
+	atomic.StoreInt32(&s.ready, 1)
+	healthServer.SetServingStatus(proto.EOTSManager_ServiceDesc.ServiceName, healthgrpc.HealthCheckResponse_SERVING)
 
 	s.logger.Info("EOTS Manager Daemon is fully active!")
 
@@ -103,6 +184,157 @@ func (s *Server) RunUntilShutdown() error {
 	return nil
 }
 
+// readinessChecks returns the checks of the /readyz endpoint. The EOTS manager
+// is ready once the RPC server is listening
+func (s *Server) readinessChecks() []metrics.ReadinessCheck {
+	return []metrics.ReadinessCheck{
+		{
+			Name: "rpc_server",
+			Check: func(_ context.Context) error {
+				if atomic.LoadInt32(&s.ready) == 0 {
+					return fmt.Errorf("the RPC server is not listening yet")
+				}
+				return nil
+			},
+		},
+	}
+}
+
+// auditLogPruneLoop periodically prunes the audit log entries older than
+// the retention until the server is shut down
+func (s *Server) auditLogPruneLoop(auditStore *store.AuditStore) {
+	ticker := time.NewTicker(auditLogPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		numPruned, err := auditStore.PruneEntries(time.Now().Add(-s.cfg.AuditLogRetention))
+		if err != nil {
+			s.logger.Error("failed to prune the audit log", zap.Error(err))
+		} else if numPruned > 0 {
+			s.logger.Info("pruned the expired audit log entries", zap.Int("num_pruned", numPruned))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-s.interceptor.ShutdownChannel():
+			return
+		}
+	}
+}
+
+// listen listens on the TCP address or the unix socket of the RPC address
+func listen(addr string) (net.Listener, error) {
+	socketPath, ok := util.UnixSocketPath(addr)
+	if !ok {
+		return net.Listen("tcp", addr)
+	}
+
+	if err := removeStaleSocket(socketPath); err != nil {
+		return nil, err
+	}
+
+	return listenUnix(socketPath)
+}
+
+// listenUnix listens on the unix socket which only the user running the EOTS
+// manager can connect to. The socket is created in a private directory and
+// moved to its path once its permissions are restricted, so that other users
+// can never connect to it
+func listenUnix(socketPath string) (net.Listener, error) {
+	if len(socketPath) > maxUnixSocketPathLen {
+		return nil, fmt.Errorf("the unix socket path %s is longer than %d bytes", socketPath, maxUnixSocketPathLen)
+	}
+
+	privateDir, err := os.MkdirTemp(filepath.Dir(socketPath), ".eotsd-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the private directory of the unix socket: %w", err)
+	}
+	defer os.RemoveAll(privateDir)
+
+	// the socket is bound with a short name, as the private directory makes
+	// the path longer than the path it is moved to
+	privatePath := filepath.Join(privateDir, "s")
+	if len(privatePath) > maxUnixSocketPathLen {
+		return nil, fmt.Errorf("the unix socket path %s is too long, as the socket is created at %s "+
+			"before being moved to it, which is longer than %d bytes", socketPath, privatePath, maxUnixSocketPathLen)
+	}
+	lis, err := net.ListenUnix("unix", &net.UnixAddr{Name: privatePath, Net: "unix"})
+	if err != nil {
+		return nil, err
+	}
+	// the socket is removed from its final path instead once closed
+	lis.SetUnlinkOnClose(false)
+
+	if err := os.Chmod(privatePath, unixSocketPerm); err != nil {
+		lis.Close()
+		return nil, fmt.Errorf("failed to restrict the permissions of the unix socket: %w", err)
+	}
+	if err := os.Rename(privatePath, socketPath); err != nil {
+		lis.Close()
+		return nil, fmt.Errorf("failed to move the unix socket to %s: %w", socketPath, err)
+	}
+	socketInfo, err := os.Lstat(socketPath)
+	if err != nil {
+		lis.Close()
+		return nil, fmt.Errorf("failed to stat the unix socket %s: %w", socketPath, err)
+	}
+
+	return &unixListener{UnixListener: lis, socketPath: socketPath, socketInfo: socketInfo}, nil
+}
+
+// unixListener removes the socket from its path once closed
+type unixListener struct {
+	*net.UnixListener
+	socketPath string
+	// socketInfo identifies the socket created by the listener, so that a
+	// file replacing it at its path, e.g., the socket of another EOTS manager,
+	// is not removed
+	socketInfo os.FileInfo
+}
+
+func (l *unixListener) Close() error {
+	err := l.UnixListener.Close()
+
+	info, statErr := os.Lstat(l.socketPath)
+	if statErr != nil || !os.SameFile(info, l.socketInfo) {
+		return err
+	}
+	if rmErr := os.Remove(l.socketPath); rmErr != nil && !os.IsNotExist(rmErr) && err == nil {
+		err = rmErr
+	}
+
+	return err
+}
+
+// removeStaleSocket removes the socket file left behind by an EOTS manager that
+// did not shut down cleanly. It refuses to remove a file that is not a socket,
+// or a socket that another process is still listening on
+func removeStaleSocket(socketPath string) error {
+	info, err := os.Lstat(socketPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%s already exists and is not a unix socket", socketPath)
+	}
+
+	conn, err := net.DialTimeout("unix", socketPath, time.Second)
+	if err == nil {
+		conn.Close()
+		return fmt.Errorf("another process is already listening on %s", socketPath)
+	}
+
+	if err := os.Remove(socketPath); err != nil {
+		return fmt.Errorf("failed to remove the stale unix socket %s: %w", socketPath, err)
+	}
+
+	return nil
+}
+
 // startGrpcListen starts the GRPC server on the passed listeners.
 func (s *Server) startGrpcListen(grpcServer *grpc.Server, listeners []net.Listener) error {
 
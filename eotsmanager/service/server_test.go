@@ -0,0 +1,100 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/util"
+)
+
+func TestListenUnixSocket(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "eotsd.sock")
+	addr := util.UnixSocketScheme + socketPath
+
+	lis, err := listen(addr)
+	require.NoError(t, err)
+	info, err := os.Stat(socketPath)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(unixSocketPerm), info.Mode().Perm())
+	// the private directory the socket is created in is removed
+	entries, err := os.ReadDir(filepath.Dir(socketPath))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	// the socket of a running EOTS manager is not removed
+	_, err = listen(addr)
+	require.ErrorContains(t, err, "already listening")
+
+	// the stale socket of an EOTS manager that did not shut down cleanly is removed
+	require.NoError(t, lis.(*unixListener).UnixListener.Close())
+	require.FileExists(t, socketPath)
+	lis, err = listen(addr)
+	require.NoError(t, err)
+	require.NoError(t, lis.Close())
+	require.NoFileExists(t, socketPath)
+
+	// a file which is not a socket is not removed
+	err = os.WriteFile(socketPath, []byte("not a socket"), 0600)
+	require.NoError(t, err)
+	_, err = listen(addr)
+	require.ErrorContains(t, err, "not a unix socket")
+	require.FileExists(t, socketPath)
+}
+
+func TestListenUnixSocketPathLength(t *testing.T) {
+	baseDir := t.TempDir()
+	sockName := "eotsd-" + strings.Repeat("s", 24) + ".sock"
+	padLen := maxUnixSocketPathLen - len(baseDir) - len(sockName) - 2
+	if padLen < 1 {
+		t.Skipf("the temporary directory %s is too long", baseDir)
+	}
+	sockDir := filepath.Join(baseDir, strings.Repeat("d", padLen))
+	require.NoError(t, os.Mkdir(sockDir, 0700))
+
+	// a socket path of the maximum length is listened on, as the socket is
+	// bound with a short name in the private directory
+	socketPath := filepath.Join(sockDir, sockName)
+	require.Len(t, socketPath, maxUnixSocketPathLen)
+	lis, err := listen(util.UnixSocketScheme + socketPath)
+	require.NoError(t, err)
+	require.NoError(t, lis.Close())
+	require.NoFileExists(t, socketPath)
+
+	// a socket path longer than the maximum length is refused
+	_, err = listen(util.UnixSocketScheme + socketPath + "x")
+	require.ErrorContains(t, err, "is longer than")
+
+	// a socket path with a short name in a long directory is refused if the
+	// private directory does not fit, which is removed
+	longDir := filepath.Join(baseDir, strings.Repeat("l", maxUnixSocketPathLen-len(baseDir)-len("/e.sock")-1))
+	require.NoError(t, os.Mkdir(longDir, 0700))
+	_, err = listen(util.UnixSocketScheme + filepath.Join(longDir, "e.sock"))
+	require.ErrorContains(t, err, "is too long")
+	entries, err := os.ReadDir(longDir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestCloseUnixListenerReplacedSocket(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "eotsd.sock")
+	addr := util.UnixSocketScheme + socketPath
+
+	lis, err := listen(addr)
+	require.NoError(t, err)
+
+	// the socket is replaced by the one of another EOTS manager, which is
+	// not removed once the listener is closed
+	require.NoError(t, os.Remove(socketPath))
+	otherLis, err := listen(addr)
+	require.NoError(t, err)
+	defer otherLis.Close()
+	require.NoError(t, lis.Close())
+	require.FileExists(t, socketPath)
+
+	require.NoError(t, otherLis.Close())
+	require.NoFileExists(t, socketPath)
+}
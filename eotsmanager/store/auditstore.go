@@ -0,0 +1,187 @@
+package store
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	pm "google.golang.org/protobuf/proto"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/proto"
+)
+
+var (
+	// mapping sequence number -> proto.AuditEntry
+	auditLogBucketName = []byte("auditLog")
+)
+
+// AuditStore is the append-only signing audit log of the EOTS manager.
+// Entries are only removed by pruning the ones older than the retention
+type AuditStore struct {
+	db kvdb.Backend
+}
+
+// AuditFilter filters the entries of the audit log, the zero values of
+// the fields do not filter anything
+type AuditFilter struct {
+	EOTSPk     []byte
+	FromHeight uint64
+	ToHeight   uint64
+	Since      time.Time
+	Until      time.Time
+	Limit      uint32
+}
+
+func NewAuditStore(db kvdb.Backend) (*AuditStore, error) {
+	s := &AuditStore{db}
+	if err := s.initBuckets(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *AuditStore) initBuckets() error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket(auditLogBucketName)
+		return err
+	})
+}
+
+// AppendEntry appends the entry to the audit log and assigns its sequence number
+func (s *AuditStore) AppendEntry(entry *proto.AuditEntry) error {
+	return s.AppendEntries([]*proto.AuditEntry{entry})
+}
+
+// AppendEntries appends the entries to the audit log in a single transaction
+// and assigns their sequence numbers in order
+func (s *AuditStore) AppendEntries(entries []*proto.AuditEntry) error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		auditBucket := tx.ReadWriteBucket(auditLogBucketName)
+		if auditBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		for _, entry := range entries {
+			id, err := auditBucket.NextSequence()
+			if err != nil {
+				return err
+			}
+			entry.Id = id
+
+			entryBytes, err := pm.Marshal(entry)
+			if err != nil {
+				return fmt.Errorf("failed to encode the audit entry: %w", err)
+			}
+
+			if err := auditBucket.Put(uint64ToBytes(id), entryBytes); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// QueryEntries returns the entries matching the filter in the order they are appended.
+// The entries are not necessarily appended in time order, as the concurrent requests
+// are recorded once they are served, so the whole log is scanned for the time range
+func (s *AuditStore) QueryEntries(filter *AuditFilter) ([]*proto.AuditEntry, error) {
+	var entries []*proto.AuditEntry
+	err := s.db.View(func(tx kvdb.RTx) error {
+		auditBucket := tx.ReadBucket(auditLogBucketName)
+		if auditBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		c := auditBucket.ReadCursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			var entry proto.AuditEntry
+			if err := pm.Unmarshal(v, &entry); err != nil {
+				return ErrCorruptedEOTSDb
+			}
+
+			if !filter.match(&entry) {
+				continue
+			}
+
+			entries = append(entries, &entry)
+			if filter.Limit > 0 && len(entries) >= int(filter.Limit) {
+				break
+			}
+		}
+
+		return nil
+	}, func() {
+		entries = nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// PruneEntries removes the entries older than the given time and
+// returns the number of removed entries. As the entries are not
+// necessarily appended in time order, the whole log is scanned
+func (s *AuditStore) PruneEntries(before time.Time) (int, error) {
+	var numPruned int
+	err := kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		numPruned = 0
+		auditBucket := tx.ReadWriteBucket(auditLogBucketName)
+		if auditBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		var keys [][]byte
+		c := auditBucket.ReadCursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			var entry proto.AuditEntry
+			if err := pm.Unmarshal(v, &entry); err != nil {
+				return ErrCorruptedEOTSDb
+			}
+			if entry.Timestamp >= before.UnixNano() {
+				continue
+			}
+			keys = append(keys, copyBytes(k))
+		}
+
+		for _, k := range keys {
+			if err := auditBucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		numPruned = len(keys)
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return numPruned, nil
+}
+
+func (f *AuditFilter) match(entry *proto.AuditEntry) bool {
+	if len(f.EOTSPk) != 0 && !bytes.Equal(f.EOTSPk, entry.EotsPk) {
+		return false
+	}
+	if entry.Height < f.FromHeight {
+		return false
+	}
+	if f.ToHeight != 0 && entry.Height > f.ToHeight {
+		return false
+	}
+	if !f.Since.IsZero() && entry.Timestamp < f.Since.UnixNano() {
+		return false
+	}
+	if !f.Until.IsZero() && entry.Timestamp > f.Until.UnixNano() {
+		return false
+	}
+
+	return true
+}
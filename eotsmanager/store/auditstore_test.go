@@ -0,0 +1,145 @@
+package store_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/proto"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/store"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+// FuzzAuditStore tests appending, querying and pruning the audit log entries
+func FuzzAuditStore(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		cfg := config.DefaultDBConfigWithHomePath(t.TempDir())
+		dbBackend, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+		defer dbBackend.Close()
+
+		as, err := store.NewAuditStore(dbBackend)
+		require.NoError(t, err)
+
+		pk1 := datagen.GenRandomByteArray(r, 32)
+		pk2 := datagen.GenRandomByteArray(r, 32)
+		chainID := []byte(testutil.GenRandomHexStr(r, 10))
+		startTime := time.Now().Add(-time.Hour)
+
+		// entries of two keys at increasing heights one second apart
+		numEntries := int(datagen.RandomInt(r, 50)) + 10
+		for i := 0; i < numEntries; i++ {
+			pk := pk1
+			if i%2 == 1 {
+				pk = pk2
+			}
+			entry := &proto.AuditEntry{
+				Timestamp: startTime.Add(time.Duration(i) * time.Second).UnixNano(),
+				Method:    "SignEOTS",
+				Caller:    "fpd",
+				EotsPk:    pk,
+				ChainId:   chainID,
+				Height:    uint64(i + 1),
+				MsgHash:   datagen.GenRandomByteArray(r, 32),
+			}
+			err := as.AppendEntry(entry)
+			require.NoError(t, err)
+			require.Equal(t, uint64(i+1), entry.Id)
+		}
+
+		entries, err := as.QueryEntries(&store.AuditFilter{})
+		require.NoError(t, err)
+		require.Len(t, entries, numEntries)
+
+		// filter by key
+		entries, err = as.QueryEntries(&store.AuditFilter{EOTSPk: pk1})
+		require.NoError(t, err)
+		require.Len(t, entries, (numEntries+1)/2)
+		for _, entry := range entries {
+			require.Equal(t, pk1, entry.EotsPk)
+		}
+
+		// filter by height range and limit
+		fromHeight := datagen.RandomInt(r, numEntries) + 1
+		toHeight := fromHeight + datagen.RandomInt(r, numEntries-int(fromHeight)+1)
+		entries, err = as.QueryEntries(&store.AuditFilter{FromHeight: fromHeight, ToHeight: toHeight})
+		require.NoError(t, err)
+		require.Len(t, entries, int(toHeight-fromHeight+1))
+		require.Equal(t, fromHeight, entries[0].Height)
+		require.Equal(t, toHeight, entries[len(entries)-1].Height)
+		entries, err = as.QueryEntries(&store.AuditFilter{FromHeight: fromHeight, ToHeight: toHeight, Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, fromHeight, entries[0].Height)
+
+		// filter by time range, the entry at height h is recorded at h-1 seconds
+		entries, err = as.QueryEntries(&store.AuditFilter{
+			Since: startTime.Add(time.Duration(fromHeight-1) * time.Second),
+			Until: startTime.Add(time.Duration(toHeight-1) * time.Second),
+		})
+		require.NoError(t, err)
+		require.Len(t, entries, int(toHeight-fromHeight+1))
+
+		// prune the entries below the from height
+		numPruned, err := as.PruneEntries(startTime.Add(time.Duration(fromHeight-1) * time.Second))
+		require.NoError(t, err)
+		require.Equal(t, int(fromHeight-1), numPruned)
+		entries, err = as.QueryEntries(&store.AuditFilter{})
+		require.NoError(t, err)
+		require.Len(t, entries, numEntries-int(fromHeight-1))
+		require.Equal(t, fromHeight, entries[0].Height)
+
+		// the sequence numbers are not reused after pruning
+		entry := &proto.AuditEntry{Timestamp: time.Now().UnixNano(), Method: "CreateKey", EotsPk: pk1}
+		err = as.AppendEntry(entry)
+		require.NoError(t, err)
+		require.Equal(t, uint64(numEntries+1), entry.Id)
+
+		// a batch of entries is appended in order
+		batch := make([]*proto.AuditEntry, int(datagen.RandomInt(r, 10))+1)
+		for i := range batch {
+			batch[i] = &proto.AuditEntry{
+				Timestamp: time.Now().UnixNano(),
+				Method:    "SignEOTSBatch",
+				EotsPk:    pk2,
+				Height:    uint64(numEntries + i + 1),
+			}
+		}
+		err = as.AppendEntries(batch)
+		require.NoError(t, err)
+		entries, err = as.QueryEntries(&store.AuditFilter{FromHeight: uint64(numEntries + 1)})
+		require.NoError(t, err)
+		require.Len(t, entries, len(batch))
+		for i, e := range entries {
+			require.Equal(t, uint64(numEntries+i+2), e.Id)
+			require.Equal(t, batch[i].Height, e.Height)
+		}
+
+		// an entry of a request served earlier may be appended after the
+		// later ones, which is still found by time and pruned
+		lateEntry := &proto.AuditEntry{
+			Timestamp: startTime.Add(-time.Second).UnixNano(),
+			Method:    "SignEOTS",
+			EotsPk:    pk1,
+		}
+		err = as.AppendEntry(lateEntry)
+		require.NoError(t, err)
+		entries, err = as.QueryEntries(&store.AuditFilter{Until: startTime})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, lateEntry.Id, entries[0].Id)
+		numPruned, err = as.PruneEntries(startTime)
+		require.NoError(t, err)
+		require.Equal(t, 1, numPruned)
+		entries, err = as.QueryEntries(&store.AuditFilter{})
+		require.NoError(t, err)
+		require.Len(t, entries, numEntries-int(fromHeight-1)+1+len(batch))
+	})
+}
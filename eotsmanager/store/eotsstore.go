@@ -1,6 +1,8 @@
 package store
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -10,7 +12,12 @@ import (
 )
 
 var (
+	// mapping pk -> key name
 	eotsBucketName = []byte("fpKeyNames")
+	// mapping pk -> chain id -> height -> hash of the signed message
+	signRecordBucketName = []byte("signRecords")
+	// mapping pk -> key name of the deleted keys
+	tombstoneBucketName = []byte("deletedKeyNames")
 )
 
 type EOTSStore struct {
@@ -33,6 +40,16 @@ func (s *EOTSStore) initBuckets() error {
 			return err
 		}
 
+		_, err = tx.CreateTopLevelBucket(signRecordBucketName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateTopLevelBucket(tombstoneBucketName)
+		if err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
@@ -49,15 +66,90 @@ func (s *EOTSStore) AddEOTSKeyName(
 			return ErrCorruptedEOTSDb
 		}
 
+		tombstoneBucket := tx.ReadWriteBucket(tombstoneBucketName)
+		if tombstoneBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
 		// check btc pk first to avoid duplicates
 		if eotsBucket.Get(pkBytes) != nil {
 			return ErrDuplicateEOTSKeyName
 		}
 
+		// a deleted key can only be added back with its previous name
+		if deletedName := tombstoneBucket.Get(pkBytes); deletedName != nil {
+			if string(deletedName) != keyName {
+				return fmt.Errorf("%w: it was named %s", ErrEOTSKeyTombstoned, deletedName)
+			}
+			if err := tombstoneBucket.Delete(pkBytes); err != nil {
+				return err
+			}
+		}
+
 		return saveEOTSKeyName(eotsBucket, pkBytes, keyName)
 	})
 }
 
+// DeleteEOTSKeyName removes the key name of the given EOTS public key and
+// leaves a tombstone so that the key can never be added back with another name
+func (s *EOTSStore) DeleteEOTSKeyName(pk []byte) error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		eotsBucket := tx.ReadWriteBucket(eotsBucketName)
+		if eotsBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		tombstoneBucket := tx.ReadWriteBucket(tombstoneBucketName)
+		if tombstoneBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		keyName := eotsBucket.Get(pk)
+		if keyName == nil {
+			return ErrEOTSKeyNameNotFound
+		}
+
+		if err := tombstoneBucket.Put(pk, copyBytes(keyName)); err != nil {
+			return err
+		}
+
+		return eotsBucket.Delete(pk)
+	})
+}
+
+// EOTSKeyName is the name of an EOTS key
+type EOTSKeyName struct {
+	PubKey []byte
+	Name   string
+}
+
+// GetAllEOTSKeyNames returns the names of all the EOTS keys that are not deleted
+func (s *EOTSStore) GetAllEOTSKeyNames() ([]*EOTSKeyName, error) {
+	var keyNames []*EOTSKeyName
+	err := s.db.View(func(tx kvdb.RTx) error {
+		eotsBucket := tx.ReadBucket(eotsBucketName)
+		if eotsBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		return eotsBucket.ForEach(func(pk, keyName []byte) error {
+			keyNames = append(keyNames, &EOTSKeyName{
+				PubKey: copyBytes(pk),
+				Name:   string(keyName),
+			})
+			return nil
+		})
+	}, func() {
+		keyNames = nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return keyNames, nil
+}
+
 func saveEOTSKeyName(
 	eotsBucket walletdb.ReadWriteBucket,
 	btcPk []byte,
@@ -93,3 +185,196 @@ func (s *EOTSStore) GetEOTSKeyName(pk []byte) (string, error) {
 
 	return keyName, nil
 }
+
+// SaveSignRecord records that the message with the given hash has been signed
+// by the EOTS key at the given chain and height. The check and the write happen
+// in the same transaction so that two conflicting messages can never be both
+// recorded. Saving an identical record again is a no-op, while saving a record
+// with a different message hash returns ErrConflictingSignRecord
+func (s *EOTSStore) SaveSignRecord(
+	pk []byte,
+	chainID []byte,
+	height uint64,
+	msgHash []byte,
+) error {
+	if len(chainID) == 0 {
+		return fmt.Errorf("cannot save signing record with empty chain id")
+	}
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		signRecordBucket := tx.ReadWriteBucket(signRecordBucketName)
+		if signRecordBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		pkBucket, err := signRecordBucket.CreateBucketIfNotExists(pk)
+		if err != nil {
+			return err
+		}
+
+		chainBucket, err := pkBucket.CreateBucketIfNotExists(chainID)
+		if err != nil {
+			return err
+		}
+
+		heightKey := uint64ToBytes(height)
+		if existing := chainBucket.Get(heightKey); existing != nil {
+			if bytes.Equal(existing, msgHash) {
+				return nil
+			}
+			return ErrConflictingSignRecord
+		}
+
+		return chainBucket.Put(heightKey, msgHash)
+	})
+}
+
+// GetSignRecord returns the hash of the message signed by the EOTS key at the
+// given chain and height
+func (s *EOTSStore) GetSignRecord(pk []byte, chainID []byte, height uint64) ([]byte, error) {
+	var msgHash []byte
+	err := s.db.View(func(tx kvdb.RTx) error {
+		signRecordBucket := tx.ReadBucket(signRecordBucketName)
+		if signRecordBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		pkBucket := signRecordBucket.NestedReadBucket(pk)
+		if pkBucket == nil {
+			return ErrSignRecordNotFound
+		}
+
+		chainBucket := pkBucket.NestedReadBucket(chainID)
+		if chainBucket == nil {
+			return ErrSignRecordNotFound
+		}
+
+		msgHashBytes := chainBucket.Get(uint64ToBytes(height))
+		if msgHashBytes == nil {
+			return ErrSignRecordNotFound
+		}
+
+		msgHash = copyBytes(msgHashBytes)
+		return nil
+	}, func() {})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return msgHash, nil
+}
+
+// SignRecord is a record of a message signed by an EOTS key
+type SignRecord struct {
+	PubKey  []byte
+	ChainID []byte
+	Height  uint64
+	MsgHash []byte
+}
+
+// GetAllSignRecords returns all the signing records of the given EOTS key,
+// or the signing records of all the EOTS keys if pk is empty
+func (s *EOTSStore) GetAllSignRecords(pk []byte) ([]*SignRecord, error) {
+	var records []*SignRecord
+	err := s.db.View(func(tx kvdb.RTx) error {
+		signRecordBucket := tx.ReadBucket(signRecordBucketName)
+		if signRecordBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		return signRecordBucket.ForEach(func(pkBytes, _ []byte) error {
+			if len(pk) != 0 && !bytes.Equal(pk, pkBytes) {
+				return nil
+			}
+			pkBucket := signRecordBucket.NestedReadBucket(pkBytes)
+			if pkBucket == nil {
+				return ErrCorruptedEOTSDb
+			}
+
+			return pkBucket.ForEach(func(chainID, _ []byte) error {
+				chainBucket := pkBucket.NestedReadBucket(chainID)
+				if chainBucket == nil {
+					return ErrCorruptedEOTSDb
+				}
+
+				return chainBucket.ForEach(func(heightKey, msgHash []byte) error {
+					if len(heightKey) != 8 {
+						return ErrCorruptedEOTSDb
+					}
+					records = append(records, &SignRecord{
+						PubKey:  copyBytes(pkBytes),
+						ChainID: copyBytes(chainID),
+						Height:  binary.BigEndian.Uint64(heightKey),
+						MsgHash: copyBytes(msgHash),
+					})
+					return nil
+				})
+			})
+		})
+	}, func() {
+		records = nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// SaveSignRecords merges the given signing records into the store
+// It is atomic: if any of the records conflicts with a record already in the
+// store or with another record to be saved, nothing is written and
+// ErrConflictingSignRecord is returned. Records that already exist are skipped
+func (s *EOTSStore) SaveSignRecords(records []*SignRecord) error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		signRecordBucket := tx.ReadWriteBucket(signRecordBucketName)
+		if signRecordBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		for _, r := range records {
+			if len(r.ChainID) == 0 {
+				return fmt.Errorf("cannot save signing record with empty chain id")
+			}
+
+			pkBucket, err := signRecordBucket.CreateBucketIfNotExists(r.PubKey)
+			if err != nil {
+				return err
+			}
+
+			chainBucket, err := pkBucket.CreateBucketIfNotExists(r.ChainID)
+			if err != nil {
+				return err
+			}
+
+			heightKey := uint64ToBytes(r.Height)
+			if existing := chainBucket.Get(heightKey); existing != nil {
+				if bytes.Equal(existing, r.MsgHash) {
+					continue
+				}
+				return fmt.Errorf("%w: pk %x, chain id %s, height %d",
+					ErrConflictingSignRecord, r.PubKey, string(r.ChainID), r.Height)
+			}
+
+			if err := chainBucket.Put(heightKey, r.MsgHash); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func copyBytes(b []byte) []byte {
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
+
+func uint64ToBytes(v uint64) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], v)
+	return buf[:]
+}
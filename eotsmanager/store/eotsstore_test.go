@@ -63,3 +63,123 @@ func FuzzEOTSStore(f *testing.F) {
 		require.ErrorIs(t, err, store.ErrEOTSKeyNameNotFound)
 	})
 }
+
+// FuzzDeleteEOTSKeyName tests that deleted keys leave a tombstone
+func FuzzDeleteEOTSKeyName(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		homePath := t.TempDir()
+		cfg := config.DefaultDBConfigWithHomePath(homePath)
+
+		dbBackend, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+
+		vs, err := store.NewEOTSStore(dbBackend)
+		require.NoError(t, err)
+
+		defer func() {
+			dbBackend.Close()
+			err := os.RemoveAll(homePath)
+			require.NoError(t, err)
+		}()
+
+		numKeys := r.Intn(5) + 2
+		btcPks := make([][]byte, 0, numKeys)
+		keyNames := make(map[string]string)
+		for i := 0; i < numKeys; i++ {
+			keyName := testutil.GenRandomHexStr(r, 10)
+			_, btcPk, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			err = vs.AddEOTSKeyName(btcPk, keyName)
+			require.NoError(t, err)
+			pkBytes := schnorr.SerializePubKey(btcPk)
+			btcPks = append(btcPks, pkBytes)
+			keyNames[string(pkBytes)] = keyName
+		}
+
+		deletedPk := btcPks[r.Intn(numKeys)]
+		err = vs.DeleteEOTSKeyName(deletedPk)
+		require.NoError(t, err)
+		err = vs.DeleteEOTSKeyName(deletedPk)
+		require.ErrorIs(t, err, store.ErrEOTSKeyNameNotFound)
+		_, err = vs.GetEOTSKeyName(deletedPk)
+		require.ErrorIs(t, err, store.ErrEOTSKeyNameNotFound)
+
+		allKeyNames, err := vs.GetAllEOTSKeyNames()
+		require.NoError(t, err)
+		require.Len(t, allKeyNames, numKeys-1)
+		for _, kn := range allKeyNames {
+			require.NotEqual(t, deletedPk, kn.PubKey)
+			require.Equal(t, keyNames[string(kn.PubKey)], kn.Name)
+		}
+
+		// the deleted key cannot be added back with another name
+		deletedBtcPk, err := schnorr.ParsePubKey(deletedPk)
+		require.NoError(t, err)
+		err = vs.AddEOTSKeyName(deletedBtcPk, testutil.GenRandomHexStr(r, 11))
+		require.ErrorIs(t, err, store.ErrEOTSKeyTombstoned)
+
+		// but it can be added back with its previous name
+		err = vs.AddEOTSKeyName(deletedBtcPk, keyNames[string(deletedPk)])
+		require.NoError(t, err)
+		keyNameFromDb, err := vs.GetEOTSKeyName(deletedPk)
+		require.NoError(t, err)
+		require.Equal(t, keyNames[string(deletedPk)], keyNameFromDb)
+	})
+}
+
+// FuzzSignRecordStore tests save and get signing records properly
+func FuzzSignRecordStore(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		homePath := t.TempDir()
+		cfg := config.DefaultDBConfigWithHomePath(homePath)
+
+		dbBackend, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+
+		vs, err := store.NewEOTSStore(dbBackend)
+		require.NoError(t, err)
+
+		defer func() {
+			dbBackend.Close()
+			err := os.RemoveAll(homePath)
+			require.NoError(t, err)
+		}()
+
+		_, btcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		pk := schnorr.SerializePubKey(btcPk)
+		chainID := datagen.GenRandomByteArray(r, 10)
+		height := datagen.RandomInt(r, 1000)
+		msgHash := datagen.GenRandomByteArray(r, 32)
+
+		_, err = vs.GetSignRecord(pk, chainID, height)
+		require.ErrorIs(t, err, store.ErrSignRecordNotFound)
+
+		err = vs.SaveSignRecord(pk, chainID, height, msgHash)
+		require.NoError(t, err)
+
+		// saving the same record again is a no-op
+		err = vs.SaveSignRecord(pk, chainID, height, msgHash)
+		require.NoError(t, err)
+
+		// saving a different message at the same height is rejected
+		err = vs.SaveSignRecord(pk, chainID, height, datagen.GenRandomByteArray(r, 32))
+		require.ErrorIs(t, err, store.ErrConflictingSignRecord)
+
+		msgHashFromDb, err := vs.GetSignRecord(pk, chainID, height)
+		require.NoError(t, err)
+		require.Equal(t, msgHash, msgHashFromDb)
+
+		// a different height or chain is not affected
+		err = vs.SaveSignRecord(pk, chainID, height+1, datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+		_, err = vs.GetSignRecord(pk, datagen.GenRandomByteArray(r, 11), height)
+		require.ErrorIs(t, err, store.ErrSignRecordNotFound)
+	})
+}
@@ -11,4 +11,13 @@ var (
 
 	// ErrEOTSKeyNameNotFound The EOTS key name we try to fetch is not found in db
 	ErrEOTSKeyNameNotFound = errors.New("EOTS key name not found")
+
+	// ErrEOTSKeyTombstoned The EOTS key we try to add has been deleted under another name
+	ErrEOTSKeyTombstoned = errors.New("EOTS key has been deleted and cannot be added with another name")
+
+	// ErrSignRecordNotFound The signing record we try to fetch is not found in db
+	ErrSignRecordNotFound = errors.New("signing record not found")
+
+	// ErrConflictingSignRecord A different message has already been signed at the same height
+	ErrConflictingSignRecord = errors.New("a conflicting signing record already exists")
 )
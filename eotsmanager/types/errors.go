@@ -4,4 +4,6 @@ import "errors"
 
 var (
 	ErrFinalityProviderAlreadyExisted = errors.New("the finality provider has already existed")
+	ErrDoubleSign                     = errors.New("double sign: a different message has already been signed at the same height")
+	ErrIncompatibleEOTSManager        = errors.New("incompatible EOTS manager")
 )
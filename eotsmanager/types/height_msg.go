@@ -0,0 +1,7 @@
+package types
+
+// HeightMsg is a message to be signed by EOTS at the given height
+type HeightMsg struct {
+	Height uint64
+	Msg    []byte
+}
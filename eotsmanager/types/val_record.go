@@ -6,3 +6,8 @@ type KeyRecord struct {
 	Name    string
 	PrivKey *btcec.PrivateKey
 }
+
+type PubKeyRecord struct {
+	Name   string
+	PubKey *btcec.PublicKey
+}
@@ -314,6 +314,7 @@ func CommandAddFinalitySig() *cobra.Command {
 	}
 	cmd.Flags().String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
 	cmd.Flags().String(appHashFlag, defaultAppHashStr, "The last commit hash of the chain block")
+	cmd.Flags().Bool(unsafeSkipConflictCheckFlag, false, "Sign the block even if it conflicts with the block signed at the same height, which gets the finality provider slashed")
 	return cmd
 }
 
@@ -338,6 +339,11 @@ func runCommandAddFinalitySig(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to read flag %s: %w", appHashFlag, err)
 	}
 
+	unsafeSkipConflictCheck, err := flags.GetBool(unsafeSkipConflictCheckFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", unsafeSkipConflictCheckFlag, err)
+	}
+
 	client, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
 	if err != nil {
 		return err
@@ -349,7 +355,7 @@ func runCommandAddFinalitySig(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	res, err := client.AddFinalitySignature(context.Background(), fpPk.MarshalHex(), blkHeight, appHash)
+	res, err := client.AddFinalitySignature(context.Background(), fpPk.MarshalHex(), blkHeight, appHash, unsafeSkipConflictCheck)
 	if err != nil {
 		return err
 	}
@@ -1,16 +1,20 @@
 package daemon
 
 const (
-	forceFlag            = "force"
-	fpEotsPkFlag         = "eots-pk"
-	rpcListenerFlag      = "rpc-listener"
-	fpdDaemonAddressFlag = "daemon-address"
-	keyNameFlag          = "key-name"
-	appHashFlag          = "app-hash"
-	passphraseFlag       = "passphrase"
-	hdPathFlag           = "hd-path"
-	chainIdFlag          = "chain-id"
-	signedFlag           = "signed"
+	forceFlag                   = "force"
+	fpEotsPkFlag                = "eots-pk"
+	rpcListenerFlag             = "rpc-listener"
+	fpdDaemonAddressFlag        = "daemon-address"
+	keyNameFlag                 = "key-name"
+	appHashFlag                 = "app-hash"
+	passphraseFlag              = "passphrase"
+	hdPathFlag                  = "hd-path"
+	chainIdFlag                 = "chain-id"
+	signedFlag                  = "signed"
+	fromHeightFlag              = "from"
+	toHeightFlag                = "to"
+	limitFlag                   = "limit"
+	unsafeSkipConflictCheckFlag = "unsafe-skip-conflict-check"
 
 	// flags for description
 	monikerFlag         = "moniker"
@@ -0,0 +1,84 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	dc "github.com/babylonlabs-io/finality-provider/finality-provider/service/client"
+)
+
+// CommandVotes returns the votes commands querying the local vote journal of the fpd daemon.
+func CommandVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "votes",
+		Short:                      "Query the finality votes recorded in the local vote journal.",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CommandListVotes())
+
+	return cmd
+}
+
+// CommandListVotes returns the votes list command by connecting to the fpd daemon.
+func CommandListVotes() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the finality votes submitted within a range of heights.",
+		Example: fmt.Sprintf(`fpd votes list --from 100 --to 200 --daemon-address %s`, defaultFpdDaemonAddress),
+		Args:    cobra.NoArgs,
+		RunE:    runCommandListVotes,
+	}
+
+	f := cmd.Flags()
+	f.String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	f.String(fpEotsPkFlag, "", "The hex string of the EOTS public key of the finality provider; the votes of all the finality providers are listed if empty")
+	f.Uint64(fromHeightFlag, 0, "The lowest height of the listed votes")
+	f.Uint64(toHeightFlag, 0, "The highest height of the listed votes; not bounded if 0")
+	f.Uint32(limitFlag, 0, "The maximum number of the listed votes, which is capped at 1000 by fpd; the cap applies if 0")
+
+	return cmd
+}
+
+func runCommandListVotes(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	daemonAddress, err := flags.GetString(fpdDaemonAddressFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", fpdDaemonAddressFlag, err)
+	}
+	fpPk, err := flags.GetString(fpEotsPkFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", fpEotsPkFlag, err)
+	}
+	fromHeight, err := flags.GetUint64(fromHeightFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", fromHeightFlag, err)
+	}
+	toHeight, err := flags.GetUint64(toHeightFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", toHeightFlag, err)
+	}
+	limit, err := flags.GetUint32(limitFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", limitFlag, err)
+	}
+
+	client, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
+	if err != nil {
+		return err
+	}
+	defer cleanUp()
+
+	resp, err := client.QueryVotes(context.Background(), fpPk, fromHeight, toHeight, limit)
+	if err != nil {
+		return err
+	}
+	printRespJSON(resp)
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"time"
 
 	bbncfg "github.com/babylonlabs-io/babylon/client/config"
@@ -21,6 +22,10 @@ type BBNConfig struct {
 	BlockTimeout   time.Duration `long:"block-timeout" description:"block timeout when waiting for block events"`
 	OutputFormat   string        `long:"output-format" description:"default output when printint responses"`
 	SignModeStr    string        `long:"sign-mode" description:"sign mode to use"`
+	// CrossVerifyRPCAddrs are the rpc servers that the block hashes are
+	// cross-verified with before voting, in addition to RPCAddr
+	CrossVerifyRPCAddrs []string `long:"cross-verify-rpc-address" description:"address of an additional rpc server to cross-verify the block hashes with before voting; can be specified multiple times"`
+	BlockQuorum         uint32   `long:"block-quorum" description:"the number of rpc servers, including the one at rpc-address, that must return the same block hash before voting for the block"`
 }
 
 func DefaultBBNConfig() BBNConfig {
@@ -42,9 +47,35 @@ func DefaultBBNConfig() BBNConfig {
 		BlockTimeout: 1 * time.Minute,
 		OutputFormat: dc.OutputFormat,
 		SignModeStr:  dc.SignModeStr,
+		BlockQuorum:  1,
 	}
 }
 
+// ValidateBlockQuorum checks that the block quorum can be reached by the
+// rpc servers to cross-verify the block hashes with
+func (bc *BBNConfig) ValidateBlockQuorum() error {
+	seen := map[string]struct{}{bc.RPCAddr: {}}
+	for _, addr := range bc.CrossVerifyRPCAddrs {
+		if addr == "" {
+			return fmt.Errorf("the cross-verify rpc address should not be empty")
+		}
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate rpc address %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+
+	if bc.BlockQuorum == 0 {
+		return fmt.Errorf("the block quorum should be positive")
+	}
+	if int(bc.BlockQuorum) > len(bc.CrossVerifyRPCAddrs)+1 {
+		return fmt.Errorf("the block quorum %d is more than the number of rpc servers %d",
+			bc.BlockQuorum, len(bc.CrossVerifyRPCAddrs)+1)
+	}
+
+	return nil
+}
+
 func BBNConfigToBabylonConfig(bc *BBNConfig) bbncfg.BabylonConfig {
 	return bbncfg.BabylonConfig{
 		Key:              bc.Key,
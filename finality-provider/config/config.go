@@ -38,6 +38,9 @@ const (
 	defaultBitcoinNetwork          = "signet"
 	defaultDataDirname             = "data"
 	defaultMaxNumFinalityProviders = 3
+	defaultMaxReadinessLag         = 20
+	// the votes are kept in the vote journal for 90 days by default
+	defaultVoteJournalRetention = 90 * 24 * time.Hour
 )
 
 var (
@@ -67,8 +70,13 @@ type Config struct {
 	FastSyncInterval         time.Duration `long:"fastsyncinterval" description:"The interval between each try of fast sync, which is disabled if the value is 0"`
 	FastSyncLimit            uint64        `long:"fastsynclimit" description:"The maximum number of blocks to catch up for each fast sync"`
 	FastSyncGap              uint64        `long:"fastsyncgap" description:"The block gap that will trigger the fast sync"`
-	EOTSManagerAddress       string        `long:"eotsmanageraddress" description:"The address of the remote EOTS manager; Empty if the EOTS manager is running locally"`
+	EOTSManagerAddress       string        `long:"eotsmanageraddress" description:"The address of the remote EOTS manager, e.g., 127.0.0.1:12582 or unix:///path/to/eotsd.sock; Empty if the EOTS manager is running in-process with the [eotsmanager] config"`
+	EOTSManagerAPIToken      string        `long:"eotsmanagerapitoken" description:"The API token identifying this client to the remote EOTS manager if it enforces a signing authorization policy"`
 	MaxNumFinalityProviders  uint32        `long:"maxnumfinalityproviders" description:"The maximum number of finality-provider instances running concurrently within the daemon"`
+	MaxReadinessLag          uint64        `long:"maxreadinesslag" description:"The maximum number of blocks that a finality-provider instance can be behind the consumer chain tip before the daemon is reported not ready"`
+	VoteJournalRetention     time.Duration `long:"votejournalretention" description:"How long the submitted votes are kept in the local vote journal; the votes are never pruned if the value is 0"`
+
+	UnsafeAllowConflictingSignatures bool `long:"unsafeallowconflictingsignatures" description:"Allow the AddFinalitySignature RPC to sign a block conflicting with the one signed at the same height, which gets the finality provider slashed (unsafe, only for testing purposes)"`
 
 	BitcoinNetwork string `long:"bitcoinnetwork" description:"Bitcoin network to run on" choise:"mainnet" choice:"regtest" choice:"testnet" choice:"simnet" choice:"signet"`
 
@@ -80,6 +88,16 @@ type Config struct {
 
 	BabylonConfig *BBNConfig `group:"babylon" namespace:"babylon"`
 
+	EOTSManagerTLS *EOTSManagerTLSConfig `group:"eotsmanagertls" namespace:"eotsmanagertls"`
+
+	EOTSManagerClient *EOTSManagerClientConfig `group:"eotsmanagerclient" namespace:"eotsmanagerclient"`
+
+	EOTSManagerConfig *EOTSManagerConfig `group:"eotsmanager" namespace:"eotsmanager"`
+
+	LightClient *LightClientConfig `group:"lightclient" namespace:"lightclient"`
+
+	Supervisor *SupervisorConfig `group:"supervisor" namespace:"supervisor"`
+
 	RpcListener string `long:"rpclistener" description:"the listener for RPC connections, e.g., 127.0.0.1:1234"`
 
 	Metrics *metrics.Config `group:"metrics" namespace:"metrics"`
@@ -111,7 +129,14 @@ func DefaultConfigWithHome(homePath string) Config {
 		EOTSManagerAddress:       defaultEOTSManagerAddress,
 		RpcListener:              DefaultRpcListener,
 		MaxNumFinalityProviders:  defaultMaxNumFinalityProviders,
+		MaxReadinessLag:          defaultMaxReadinessLag,
+		VoteJournalRetention:     defaultVoteJournalRetention,
 		Metrics:                  metrics.DefaultFpConfig(),
+		EOTSManagerTLS:           DefaultEOTSManagerTLSConfig(),
+		EOTSManagerClient:        DefaultEOTSManagerClientConfig(),
+		EOTSManagerConfig:        DefaultEOTSManagerConfigWithHomePath(homePath),
+		LightClient:              DefaultLightClientConfig(),
+		Supervisor:               DefaultSupervisorConfig(),
 	}
 
 	if err := cfg.Validate(); err != nil {
@@ -178,8 +203,14 @@ func LoadConfig(homePath string) (*Config, error) {
 // illegal values or a combination of values are set. All file system paths are
 // normalized. The cleaned up config is returned on success.
 func (cfg *Config) Validate() error {
+	// an empty EOTS manager address means running the EOTS manager in-process
 	if cfg.EOTSManagerAddress == "" {
-		return fmt.Errorf("EOTS manager address not specified")
+		if cfg.EOTSManagerConfig == nil {
+			return fmt.Errorf("the EOTS manager address is empty while the in-process EOTS manager config is not specified")
+		}
+		if err := cfg.EOTSManagerConfig.Validate(); err != nil {
+			return fmt.Errorf("invalid in-process EOTS manager config: %w", err)
+		}
 	}
 	// Multiple networks can't be selected simultaneously.  Count number of
 	// network flags passed; assign active network params
@@ -190,6 +221,18 @@ func (cfg *Config) Validate() error {
 	}
 	cfg.BTCNetParams = btcNetConfig
 
+	if cfg.BabylonConfig == nil {
+		return fmt.Errorf("empty babylon config")
+	}
+
+	if err := cfg.BabylonConfig.ValidateBlockQuorum(); err != nil {
+		return fmt.Errorf("invalid babylon config: %w", err)
+	}
+
+	if cfg.VoteJournalRetention < 0 {
+		return fmt.Errorf("the vote journal retention should not be negative")
+	}
+
 	_, err = net.ResolveTCPAddr("tcp", cfg.RpcListener)
 	if err != nil {
 		return fmt.Errorf("invalid RPC listener address %s, %w", cfg.RpcListener, err)
@@ -203,6 +246,38 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("invalid metrics config")
 	}
 
+	if err := cfg.EOTSManagerTLS.Validate(); err != nil {
+		return fmt.Errorf("invalid EOTS manager TLS config: %w", err)
+	}
+
+	if cfg.EOTSManagerClient == nil {
+		return fmt.Errorf("empty EOTS manager client config")
+	}
+
+	if err := cfg.EOTSManagerClient.Validate(cfg.EOTSManagerAddress); err != nil {
+		return fmt.Errorf("invalid EOTS manager client config: %w", err)
+	}
+
+	if err := cfg.LightClient.Validate(); err != nil {
+		return fmt.Errorf("invalid light client config: %w", err)
+	}
+
+	if cfg.PollerConfig == nil {
+		return fmt.Errorf("empty chain poller config")
+	}
+
+	if err := cfg.PollerConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid chain poller config: %w", err)
+	}
+
+	if cfg.Supervisor == nil {
+		return fmt.Errorf("empty supervisor config")
+	}
+
+	if err := cfg.Supervisor.Validate(); err != nil {
+		return fmt.Errorf("invalid supervisor config: %w", err)
+	}
+
 	// All good, return the sanitized result.
 	return nil
 }
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+
+	eotscfg "github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+)
+
+const (
+	defaultEOTSKeyDirname     = "eots"
+	defaultEOTSKeyringBackend = keyring.BackendTest
+	defaultEOTSDbName         = "eots.db"
+)
+
+// EOTSManagerConfig is the config of the EOTS manager running in-process,
+// which is only used if EOTSManagerAddress is empty
+type EOTSManagerConfig struct {
+	KeyDirectory   string `long:"keydirectory" description:"Directory of the keyring of the in-process EOTS manager; it should differ from the key directory of the consumer chain"`
+	KeyringBackend string `long:"keyringbackend" description:"Type of keyring of the in-process EOTS manager" choice:"test" choice:"file" choice:"os"`
+	DBPath         string `long:"dbpath" description:"The directory path in which the database file of the in-process EOTS manager should be stored"`
+	DBFileName     string `long:"dbfilename" description:"The name of the database file of the in-process EOTS manager"`
+}
+
+func DefaultEOTSManagerConfigWithHomePath(homePath string) *EOTSManagerConfig {
+	return &EOTSManagerConfig{
+		KeyDirectory:   filepath.Join(homePath, defaultEOTSKeyDirname),
+		KeyringBackend: defaultEOTSKeyringBackend,
+		DBPath:         DataDir(homePath),
+		DBFileName:     defaultEOTSDbName,
+	}
+}
+
+func (cfg *EOTSManagerConfig) Validate() error {
+	if cfg.KeyDirectory == "" {
+		return fmt.Errorf("the key directory should not be empty")
+	}
+
+	if cfg.KeyringBackend == "" {
+		return fmt.Errorf("the keyring backend should not be empty")
+	}
+
+	if cfg.DBPath == "" || cfg.DBFileName == "" {
+		return fmt.Errorf("the database path and file name should not be empty")
+	}
+
+	return nil
+}
+
+// DBConfig returns the database config of the in-process EOTS manager
+func (cfg *EOTSManagerConfig) DBConfig() *eotscfg.DBConfig {
+	dbCfg := eotscfg.DefaultDBConfig()
+	dbCfg.DBPath = cfg.DBPath
+	dbCfg.DBFileName = cfg.DBFileName
+
+	return dbCfg
+}
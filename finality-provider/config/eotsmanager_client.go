@@ -0,0 +1,48 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/client"
+)
+
+// EOTSManagerClientConfig is the config of the connection to the remote EOTS manager
+type EOTSManagerClientConfig struct {
+	// FailoverAddresses are the standby EOTS managers tried in order if
+	// the one at the EOTS manager address is unavailable
+	FailoverAddresses []string      `long:"failoveraddress" description:"The address of a standby EOTS manager to fail over to if the EOTS manager address is unavailable; can be specified multiple times and is tried in order"`
+	CallTimeout       time.Duration `long:"calltimeout" description:"The deadline of each request to an EOTS manager before failing over to the next one"`
+	KeepaliveTime     time.Duration `long:"keepalivetime" description:"The interval of pinging an idle connection to an EOTS manager"`
+	KeepaliveTimeout  time.Duration `long:"keepalivetimeout" description:"The time to wait for the ping ack before reconnecting to an EOTS manager"`
+}
+
+func DefaultEOTSManagerClientConfig() *EOTSManagerClientConfig {
+	return &EOTSManagerClientConfig{
+		CallTimeout:      client.DefaultCallTimeout,
+		KeepaliveTime:    client.DefaultKeepaliveTime,
+		KeepaliveTimeout: client.DefaultKeepaliveTimeout,
+	}
+}
+
+// ClientConfig returns the config of the gRPC client of the EOTS manager, which
+// connects to the primary address and then the failover addresses
+func (cfg *EOTSManagerClientConfig) ClientConfig(primaryAddress string) *client.Config {
+	clientCfg := client.DefaultConfig(append([]string{primaryAddress}, cfg.FailoverAddresses...)...)
+	clientCfg.CallTimeout = cfg.CallTimeout
+	clientCfg.KeepaliveTime = cfg.KeepaliveTime
+	clientCfg.KeepaliveTimeout = cfg.KeepaliveTimeout
+
+	return clientCfg
+}
+
+func (cfg *EOTSManagerClientConfig) Validate(primaryAddress string) error {
+	if primaryAddress == "" {
+		if len(cfg.FailoverAddresses) != 0 {
+			return fmt.Errorf("the failover addresses require the EOTS manager address to be specified")
+		}
+		return nil
+	}
+
+	return cfg.ClientConfig(primaryAddress).Validate()
+}
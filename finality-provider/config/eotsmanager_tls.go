@@ -0,0 +1,57 @@
+package config
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	"github.com/babylonlabs-io/finality-provider/util"
+)
+
+// EOTSManagerTLSConfig is the TLS config of the connection to the remote EOTS manager
+type EOTSManagerTLSConfig struct {
+	// CAFile is the CA certificate to verify the EOTS manager certificate.
+	// TLS is disabled if it is empty
+	CAFile string `long:"cafile" description:"Path to the CA certificate to verify the EOTS manager; TLS is disabled if empty"`
+
+	// CertFile and KeyFile are the client certificate and private key
+	// presented to the EOTS manager if it requires client authentication
+	CertFile string `long:"certfile" description:"Path to the TLS client certificate presented to the EOTS manager"`
+	KeyFile  string `long:"keyfile" description:"Path to the TLS client private key"`
+
+	ServerName string `long:"servername" description:"Overrides the host name used to verify the EOTS manager certificate"`
+}
+
+func DefaultEOTSManagerTLSConfig() *EOTSManagerTLSConfig {
+	return &EOTSManagerTLSConfig{}
+}
+
+// Enabled returns whether TLS is enabled for the connection to the EOTS manager
+func (cfg *EOTSManagerTLSConfig) Enabled() bool {
+	return cfg != nil && cfg.CAFile != ""
+}
+
+func (cfg *EOTSManagerTLSConfig) Validate() error {
+	if cfg == nil {
+		return nil
+	}
+
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		return fmt.Errorf("the TLS client certificate and key files should be specified together")
+	}
+
+	if cfg.CertFile != "" && cfg.CAFile == "" {
+		return fmt.Errorf("the TLS client certificate requires the CA file to verify the EOTS manager")
+	}
+
+	return nil
+}
+
+// ClientTLSConfig loads the TLS config of the connection to the EOTS manager,
+// or returns nil if TLS is disabled
+func (cfg *EOTSManagerTLSConfig) ClientTLSConfig() (*tls.Config, error) {
+	if !cfg.Enabled() {
+		return nil, nil
+	}
+
+	return util.LoadClientTLSConfig(cfg.CAFile, cfg.CertFile, cfg.KeyFile, cfg.ServerName)
+}
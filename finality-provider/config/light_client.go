@@ -0,0 +1,77 @@
+package config
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+)
+
+const (
+	defaultLightClientTrustingPeriod = 7 * 24 * time.Hour
+	defaultLightClientPruningSize    = 1000
+)
+
+// LightClientConfig is the config of the CometBFT light client that verifies
+// the blocks against the validator set of the consumer chain before voting
+type LightClientConfig struct {
+	// TrustedHeight and TrustedHash are the header that the light client is
+	// seeded from. The light client verification is disabled if they are empty
+	TrustedHeight  uint64        `long:"trustedheight" description:"The height of the trusted header that the light client is seeded from; the light client verification is disabled if it is 0"`
+	TrustedHash    string        `long:"trustedhash" description:"The hex-encoded hash of the trusted header at the trusted height"`
+	TrustingPeriod time.Duration `long:"trustingperiod" description:"The period during which the validator set of a verified header is trusted, which should be significantly shorter than the unbonding period"`
+	Witnesses      []string      `long:"witness" description:"The address of an rpc server to cross-check the verified headers with to detect attacks on the light client; can be specified multiple times and the rpc-address of the babylon config is used if empty"`
+	PruningSize    uint16        `long:"pruningsize" description:"The maximum number of verified headers kept in the database"`
+}
+
+func DefaultLightClientConfig() *LightClientConfig {
+	return &LightClientConfig{
+		TrustingPeriod: defaultLightClientTrustingPeriod,
+		PruningSize:    defaultLightClientPruningSize,
+	}
+}
+
+// Enabled returns whether the blocks are verified by the light client
+func (cfg *LightClientConfig) Enabled() bool {
+	return cfg != nil && cfg.TrustedHeight != 0
+}
+
+// TrustedHashBytes returns the decoded hash of the trusted header
+func (cfg *LightClientConfig) TrustedHashBytes() ([]byte, error) {
+	hash, err := hex.DecodeString(cfg.TrustedHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trusted hash %s: %w", cfg.TrustedHash, err)
+	}
+	if len(hash) != tmhash.Size {
+		return nil, fmt.Errorf("the trusted hash should be %d bytes, got %d bytes", tmhash.Size, len(hash))
+	}
+
+	return hash, nil
+}
+
+func (cfg *LightClientConfig) Validate() error {
+	if !cfg.Enabled() {
+		if cfg != nil && cfg.TrustedHash != "" {
+			return fmt.Errorf("the trusted hash requires the trusted height to be specified")
+		}
+		return nil
+	}
+
+	if _, err := cfg.TrustedHashBytes(); err != nil {
+		return err
+	}
+	if cfg.TrustingPeriod <= 0 {
+		return fmt.Errorf("the trusting period should be positive")
+	}
+	if cfg.PruningSize == 0 {
+		return fmt.Errorf("the pruning size should be positive")
+	}
+	for _, w := range cfg.Witnesses {
+		if w == "" {
+			return fmt.Errorf("the witness address should not be empty")
+		}
+	}
+
+	return nil
+}
@@ -1,18 +1,26 @@
 package config
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 var (
 	defaultBufferSize        = uint32(1000)
 	defaultPollingInterval   = 20 * time.Second
 	defaultStaticStartHeight = uint64(1)
+	defaultCatchUpThreshold  = uint64(100)
+	defaultCatchUpPageSize   = uint64(100)
 )
 
 type ChainPollerConfig struct {
-	BufferSize                     uint32        `long:"buffersize" description:"The maximum number of Babylon blocks that can be stored in the buffer"`
+	BufferSize                     uint32        `long:"buffersize" description:"The maximum number of Babylon blocks that can be stored in the buffer of each finality provider"`
 	PollInterval                   time.Duration `long:"pollinterval" description:"The interval between each polling of Babylon blocks"`
 	StaticChainScanningStartHeight uint64        `long:"staticchainscanningstartheight" description:"The static height from which we start polling the chain"`
 	AutoChainScanningMode          bool          `long:"autochainscanningmode" description:"Automatically discover the height from which to start polling the chain"`
+	NewBlockSubscription           bool          `long:"newblocksubscription" description:"Subscribe to the new blocks through the websocket of the rpc server to retrieve them as soon as they are produced, while polling is kept as the fallback"`
+	CatchUpThreshold               uint64        `long:"catchupthreshold" description:"The number of blocks behind the tip from which the poller retrieves the blocks in pages until it catches up (0 disables the catch-up mode)"`
+	CatchUpPageSize                uint64        `long:"catchuppagesize" description:"The maximum number of blocks retrieved in each page while catching up"`
 }
 
 func DefaultChainPollerConfig() ChainPollerConfig {
@@ -21,5 +29,16 @@ func DefaultChainPollerConfig() ChainPollerConfig {
 		PollInterval:                   defaultPollingInterval,
 		StaticChainScanningStartHeight: defaultStaticStartHeight,
 		AutoChainScanningMode:          true,
+		NewBlockSubscription:           true,
+		CatchUpThreshold:               defaultCatchUpThreshold,
+		CatchUpPageSize:                defaultCatchUpPageSize,
 	}
 }
+
+func (cfg *ChainPollerConfig) Validate() error {
+	if cfg.CatchUpThreshold > 0 && cfg.CatchUpPageSize == 0 {
+		return fmt.Errorf("the catch-up page size should be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// ExitPolicyNever never exits the daemon, and the finality-provider
+	// instance is stopped if its critical error cannot be resolved by restarts
+	ExitPolicyNever = "never"
+	// ExitPolicyUnrecoverable exits the daemon if the critical error cannot be
+	// resolved by restarts, i.e., a block is proven not to match the header
+	// verified by the light client, signing would conflict with a previous
+	// vote, or the restarts are exhausted
+	ExitPolicyUnrecoverable = "unrecoverable"
+	// ExitPolicyAlways exits the daemon on any critical error except slashing
+	ExitPolicyAlways = "always"
+
+	defaultRestartBackoff    = 5 * time.Second
+	defaultMaxRestartBackoff = 5 * time.Minute
+	defaultMaxRestarts       = 10
+	defaultRestartResetAfter = 30 * time.Minute
+)
+
+// SupervisorConfig is the config of restarting the finality-provider
+// instances that encounter critical errors
+type SupervisorConfig struct {
+	ExitPolicy        string        `long:"exitpolicy" description:"When to exit the daemon on a critical error of a finality provider" choice:"never" choice:"unrecoverable" choice:"always"`
+	RestartBackoff    time.Duration `long:"restartbackoff" description:"The delay before restarting a finality provider after a critical error, which doubles on each consecutive restart"`
+	MaxRestartBackoff time.Duration `long:"maxrestartbackoff" description:"The maximum delay before restarting a finality provider"`
+	MaxRestarts       uint32        `long:"maxrestarts" description:"The maximum number of consecutive restarts of a finality provider, after which its error is considered unrecoverable (0 for unlimited)"`
+	RestartResetAfter time.Duration `long:"restartresetafter" description:"How long a restarted finality provider should run without critical errors for its consecutive restarts to be reset"`
+}
+
+func DefaultSupervisorConfig() *SupervisorConfig {
+	return &SupervisorConfig{
+		ExitPolicy:        ExitPolicyUnrecoverable,
+		RestartBackoff:    defaultRestartBackoff,
+		MaxRestartBackoff: defaultMaxRestartBackoff,
+		MaxRestarts:       defaultMaxRestarts,
+		RestartResetAfter: defaultRestartResetAfter,
+	}
+}
+
+// RestartDelay returns the delay before the restart following the given
+// number of consecutive restarts
+func (cfg *SupervisorConfig) RestartDelay(consecutiveRestarts uint32) time.Duration {
+	delay := cfg.RestartBackoff
+	for i := uint32(0); i < consecutiveRestarts && delay < cfg.MaxRestartBackoff; i++ {
+		delay *= 2
+	}
+	if delay > cfg.MaxRestartBackoff {
+		delay = cfg.MaxRestartBackoff
+	}
+
+	return delay
+}
+
+func (cfg *SupervisorConfig) Validate() error {
+	switch cfg.ExitPolicy {
+	case ExitPolicyNever, ExitPolicyUnrecoverable, ExitPolicyAlways:
+	default:
+		return fmt.Errorf("invalid exit policy %s", cfg.ExitPolicy)
+	}
+	if cfg.RestartBackoff <= 0 {
+		return fmt.Errorf("the restart backoff should be positive")
+	}
+	if cfg.MaxRestartBackoff < cfg.RestartBackoff {
+		return fmt.Errorf("the max restart backoff should be no less than the restart backoff")
+	}
+	if cfg.RestartResetAfter <= 0 {
+		return fmt.Errorf("the restart reset period should be positive")
+	}
+
+	return nil
+}
@@ -34,6 +34,8 @@ type FinalityProvidersClient interface {
 	QueryFinalityProviderList(ctx context.Context, in *QueryFinalityProviderListRequest, opts ...grpc.CallOption) (*QueryFinalityProviderListResponse, error)
 	// SignMessageFromChainKey signs a message from the chain keyring.
 	SignMessageFromChainKey(ctx context.Context, in *SignMessageFromChainKeyRequest, opts ...grpc.CallOption) (*SignMessageFromChainKeyResponse, error)
+	// QueryVotes queries the finality votes recorded in the local vote journal
+	QueryVotes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error)
 }
 
 type finalityProvidersClient struct {
@@ -107,6 +109,15 @@ func (c *finalityProvidersClient) SignMessageFromChainKey(ctx context.Context, i
 	return out, nil
 }
 
+func (c *finalityProvidersClient) QueryVotes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error) {
+	out := new(QueryVotesResponse)
+	err := c.cc.Invoke(ctx, "/proto.FinalityProviders/QueryVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FinalityProvidersServer is the server API for FinalityProviders service.
 // All implementations must embed UnimplementedFinalityProvidersServer
 // for forward compatibility
@@ -127,6 +138,8 @@ type FinalityProvidersServer interface {
 	QueryFinalityProviderList(context.Context, *QueryFinalityProviderListRequest) (*QueryFinalityProviderListResponse, error)
 	// SignMessageFromChainKey signs a message from the chain keyring.
 	SignMessageFromChainKey(context.Context, *SignMessageFromChainKeyRequest) (*SignMessageFromChainKeyResponse, error)
+	// QueryVotes queries the finality votes recorded in the local vote journal
+	QueryVotes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error)
 	mustEmbedUnimplementedFinalityProvidersServer()
 }
 
@@ -155,6 +168,9 @@ func (UnimplementedFinalityProvidersServer) QueryFinalityProviderList(context.Co
 func (UnimplementedFinalityProvidersServer) SignMessageFromChainKey(context.Context, *SignMessageFromChainKeyRequest) (*SignMessageFromChainKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignMessageFromChainKey not implemented")
 }
+func (UnimplementedFinalityProvidersServer) QueryVotes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVotes not implemented")
+}
 func (UnimplementedFinalityProvidersServer) mustEmbedUnimplementedFinalityProvidersServer() {}
 
 // UnsafeFinalityProvidersServer may be embedded to opt out of forward compatibility for this service.
@@ -294,6 +310,24 @@ func _FinalityProviders_SignMessageFromChainKey_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FinalityProviders_QueryVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinalityProvidersServer).QueryVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.FinalityProviders/QueryVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinalityProvidersServer).QueryVotes(ctx, req.(*QueryVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FinalityProviders_ServiceDesc is the grpc.ServiceDesc for FinalityProviders service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -329,6 +363,10 @@ var FinalityProviders_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SignMessageFromChainKey",
 			Handler:    _FinalityProviders_SignMessageFromChainKey_Handler,
 		},
+		{
+			MethodName: "QueryVotes",
+			Handler:    _FinalityProviders_QueryVotes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "finality_providers.proto",
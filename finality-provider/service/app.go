@@ -1,6 +1,7 @@
 package service
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strings"
 	"sync"
@@ -10,6 +11,7 @@ import (
 	bbntypes "github.com/babylonlabs-io/babylon/types"
 	bstypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,6 +30,9 @@ import (
 	"github.com/babylonlabs-io/finality-provider/types"
 )
 
+// voteJournalPruneInterval is the interval of pruning the expired votes from the vote journal
+const voteJournalPruneInterval = time.Hour
+
 type FinalityProviderApp struct {
 	startOnce sync.Once
 	stopOnce  sync.Once
@@ -65,12 +70,47 @@ func NewFinalityProviderAppFromConfig(
 
 	// if the EOTSManagerAddress is empty, run a local EOTS manager;
 	// otherwise connect a remote one with a gRPC client
-	em, err := client.NewEOTSManagerGRpcClient(cfg.EOTSManagerAddress)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create EOTS manager client: %w", err)
-	}
+	var em eotsmanager.EOTSManager
+	if cfg.EOTSManagerAddress == "" {
+		em, err = newInProcessEOTSManager(cfg.EOTSManagerConfig, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create in-process EOTS manager: %w", err)
+		}
+
+		logger.Info("successfully started an in-process EOTS manager",
+			zap.String("key_dir", cfg.EOTSManagerConfig.KeyDirectory))
+	} else {
+		tlsCfg, err := cfg.EOTSManagerTLS.ClientTLSConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS config of the EOTS manager client: %w", err)
+		}
+		emClientCfg := cfg.EOTSManagerClient.ClientConfig(cfg.EOTSManagerAddress)
+		emClientCfg.TLSConfig = tlsCfg
+		emClientCfg.APIToken = cfg.EOTSManagerAPIToken
+		emClientCfg.HealthObserver = metrics.NewFpMetrics()
+		emClient, err := client.NewEOTSManagerGRpcClientFromConfig(emClientCfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create EOTS manager client: %w", err)
+		}
+
+		// refuse to run against an incompatible EOTS manager rather than
+		// failing later when signing
+		info, err := emClient.CheckCompatibility()
+		if err != nil {
+			emClient.Close()
+			return nil, fmt.Errorf("the EOTS manager at %s cannot be used: %w", emClient.ActiveEndpoint(), err)
+		}
+		em = emClient
 
-	logger.Info("successfully connected to a remote EOTS manager", zap.String("address", cfg.EOTSManagerAddress))
+		logger.Info("successfully connected to a remote EOTS manager",
+			zap.String("address", emClient.ActiveEndpoint()),
+			zap.Strings("failover_addresses", cfg.EOTSManagerClient.FailoverAddresses),
+			zap.Bool("tls", tlsCfg != nil),
+			zap.String("version", info.Version),
+			zap.Uint32("api_version", info.ApiVersion),
+			zap.Uint32("num_keys", info.NumKeys),
+		)
+	}
 
 	return NewFinalityProviderApp(cfg, cc, em, db, logger)
 }
@@ -102,9 +142,30 @@ func NewFinalityProviderApp(
 		return nil, fmt.Errorf("failed to create keyring: %w", err)
 	}
 
+	// the blocks are only verified by the light client if it is configured
+	var verifier HeaderVerifier
+	if config.LightClient.Enabled() {
+		lc, err := NewLightClientVerifier(
+			config.LightClient,
+			config.BabylonConfig.ChainID,
+			config.BabylonConfig.RPCAddr,
+			db,
+			logger,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the light client verifier: %w", err)
+		}
+		verifier = lc
+
+		logger.Info("the blocks are verified by the light client",
+			zap.Uint64("trusted_height", config.LightClient.TrustedHeight),
+			zap.Strings("witnesses", config.LightClient.Witnesses),
+		)
+	}
+
 	fpMetrics := metrics.NewFpMetrics()
 
-	fpm, err := NewFinalityProviderManager(fpStore, pubRandStore, config, cc, em, fpMetrics, logger)
+	fpm, err := NewFinalityProviderManager(fpStore, pubRandStore, config, cc, verifier, em, fpMetrics, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create finality-provider manager: %w", err)
 	}
@@ -226,14 +287,38 @@ func (app *FinalityProviderApp) StartHandlingAll() error {
 	return app.fpManager.StartAll()
 }
 
-// NOTE: this is not safe in production, so only used for testing purpose
-func (app *FinalityProviderApp) getFpPrivKey(fpPk []byte) (*btcec.PrivateKey, error) {
-	record, err := app.eotsManager.KeyRecord(fpPk, "")
+// verifyExtractedPrivKey checks whether the extracted private key corresponds to
+// the EOTS key of the finality provider managed by the EOTS manager
+func (app *FinalityProviderApp) verifyExtractedPrivKey(fpPk *bbntypes.BIP340PubKey, extractedSk *btcec.PrivateKey) error {
+	record, err := app.eotsManager.PubKeyRecord(fpPk.MustMarshal())
+	if err != nil {
+		return fmt.Errorf("failed to get the EOTS key of finality-provider %s: %w", fpPk.MarshalHex(), err)
+	}
+
+	localPk := bbntypes.NewBIP340PubKeyFromBTCPK(record.PubKey)
+	extractedPk := bbntypes.NewBIP340PubKeyFromBTCPK(extractedSk.PubKey())
+	if !localPk.Equals(extractedPk) {
+		return fmt.Errorf("the finality-provider's BTC private key is extracted but does not match the local key, "+
+			"extracted pk: %s, local pk: %s", extractedPk.MarshalHex(), localPk.MarshalHex())
+	}
+
+	return nil
+}
+
+// createPop creates the proof-of-possession of the finality provider by letting
+// the EOTS manager sign the hash of the finality provider address so that the
+// EOTS private key never leaves the EOTS manager
+func (app *FinalityProviderApp) createPop(fpAddr sdk.AccAddress, fpPk *bbntypes.BIP340PubKey, passphrase string) (*bstypes.ProofOfPossessionBTC, error) {
+	hash := tmhash.Sum(fpAddr.Bytes())
+	btcSig, err := app.eotsManager.SignSchnorrSig(fpPk.MustMarshal(), hash, passphrase)
 	if err != nil {
 		return nil, err
 	}
 
-	return record.PrivKey, nil
+	return &bstypes.ProofOfPossessionBTC{
+		BtcSigType: bstypes.BTCSigType_BIP340,
+		BtcSig:     bbntypes.NewBIP340SignatureFromBTCSig(btcSig).MustMarshal(),
+	}, nil
 }
 
 // SyncFinalityProviderStatus syncs the status of the finality-providers
@@ -293,6 +378,11 @@ func (app *FinalityProviderApp) Start() error {
 		go app.eventLoop()
 		go app.registrationLoop()
 		go app.metricsUpdateLoop()
+
+		if app.config.VoteJournalRetention > 0 {
+			app.wg.Add(1)
+			go app.voteJournalPruneLoop()
+		}
 	})
 
 	return startErr
@@ -314,6 +404,12 @@ func (app *FinalityProviderApp) Stop() error {
 			return
 		}
 
+		app.logger.Debug("Stopping the consumer chain client")
+		if err := app.cc.Close(); err != nil {
+			stopErr = err
+			return
+		}
+
 		app.logger.Debug("Stopping EOTS manager")
 		if err := app.eotsManager.Close(); err != nil {
 			stopErr = err
@@ -383,13 +479,8 @@ func (app *FinalityProviderApp) handleCreateFinalityProviderRequest(req *createF
 	if err != nil {
 		return nil, err
 	}
-	fpRecord, err := app.eotsManager.KeyRecord(fpPk.MustMarshal(), req.passPhrase)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get finality-provider record: %w", err)
-	}
-
 	// 3. create proof-of-possession
-	pop, err := kr.CreatePop(fpAddr, fpRecord.PrivKey)
+	pop, err := app.createPop(fpAddr, fpPk, req.passPhrase)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create proof-of-possession of the finality-provider: %w", err)
 	}
@@ -481,13 +572,8 @@ func (app *FinalityProviderApp) StoreFinalityProvider(
 	if err != nil {
 		return nil, err
 	}
-	fpRecord, err := app.eotsManager.KeyRecord(fpPk.MustMarshal(), passPhrase)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get finality-provider record: %w", err)
-	}
-
 	// 3. create proof-of-possession
-	pop, err := kr.CreatePop(fpAddr, fpRecord.PrivKey)
+	pop, err := app.createPop(fpAddr, fpPk, passPhrase)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create proof-of-possession of the finality provider: %w", err)
 	}
@@ -653,3 +739,51 @@ func (app *FinalityProviderApp) metricsUpdateLoop() {
 		}
 	}
 }
+
+// voteJournalPruneLoop periodically prunes the votes older than the
+// retention from the vote journal
+func (app *FinalityProviderApp) voteJournalPruneLoop() {
+	defer app.wg.Done()
+
+	pruneTicker := time.NewTicker(voteJournalPruneInterval)
+	defer pruneTicker.Stop()
+
+	for {
+		numPruned, err := app.fps.PruneVotes(time.Now().Add(-app.config.VoteJournalRetention))
+		if err != nil {
+			app.logger.Error("failed to prune the vote journal", zap.Error(err))
+		} else if numPruned > 0 {
+			app.logger.Info("pruned the expired votes from the vote journal", zap.Int("num_pruned", numPruned))
+		}
+
+		select {
+		case <-pruneTicker.C:
+		case <-app.quit:
+			app.logger.Debug("exiting vote journal prune loop")
+			return
+		}
+	}
+}
+
+// QueryVotes returns the votes recorded in the vote journal
+func (app *FinalityProviderApp) QueryVotes(filter *store.VoteFilter) ([]*proto.VoteInfo, error) {
+	votes, err := app.fps.QueryVotes(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	voteInfos := make([]*proto.VoteInfo, 0, len(votes))
+	for _, v := range votes {
+		voteInfos = append(voteInfos, &proto.VoteInfo{
+			BtcPkHex:     hex.EncodeToString(v.BtcPk),
+			Height:       v.Height,
+			BlockHashHex: hex.EncodeToString(v.BlockHash),
+			PubRandHex:   hex.EncodeToString(v.PubRand),
+			TxHash:       v.TxHash,
+			SubmittedAt:  time.Unix(0, v.Timestamp).UTC().Format(time.RFC3339),
+			Batched:      v.Batched,
+		})
+	}
+
+	return voteInfos, nil
+}
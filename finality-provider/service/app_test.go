@@ -8,6 +8,7 @@ import (
 
 	bbntypes "github.com/babylonlabs-io/babylon/types"
 	bstypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -122,3 +123,55 @@ func FuzzRegisterFinalityProvider(f *testing.F) {
 		require.Equal(t, true, fpInfo.IsRunning)
 	})
 }
+
+func FuzzInProcessEOTSManager(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 3)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		logger := zap.NewNop()
+		// an empty EOTS manager address runs the EOTS manager in-process
+		fpHomeDir := filepath.Join(t.TempDir(), "fp-home")
+		fpCfg := config.DefaultConfigWithHome(fpHomeDir)
+		fpCfg.EOTSManagerAddress = ""
+		err := fpCfg.Validate()
+		require.NoError(t, err)
+		fpdb, err := fpCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			err = fpdb.Close()
+			require.NoError(t, err)
+		}()
+		app, err := service.NewFinalityProviderAppFromConfig(&fpCfg, fpdb, logger)
+		require.NoError(t, err)
+
+		err = app.Start()
+		require.NoError(t, err)
+		err = app.StartHandlingAll()
+		require.NoError(t, err)
+
+		// the EOTS key is created and the PoP is signed by the in-process EOTS manager
+		fp := testutil.GenStoredFinalityProvider(r, t, app, passphrase, hdPath)
+		pop := &bstypes.ProofOfPossessionBTC{
+			BtcSig:     fp.Pop.BtcSig,
+			BtcSigType: bstypes.BTCSigType_BIP340,
+		}
+		fpAddr, err := sdk.AccAddressFromBech32(fp.FPAddr)
+		require.NoError(t, err)
+		err = pop.VerifyBIP340(fpAddr, fp.GetBIP340BTCPK())
+		require.NoError(t, err)
+
+		// stopping the app closes the database of the in-process EOTS manager
+		// so that it can be opened again
+		err = app.Stop()
+		require.NoError(t, err)
+		eotsDb, err := fpCfg.EOTSManagerConfig.DBConfig().GetDbBackend()
+		require.NoError(t, err)
+		defer eotsDb.Close()
+		em, err := eotsmanager.NewLocalEOTSManager(fpCfg.EOTSManagerConfig.KeyDirectory, fpCfg.EOTSManagerConfig.KeyringBackend, eotsDb, logger)
+		require.NoError(t, err)
+		record, err := em.PubKeyRecord(fp.GetBIP340BTCPK().MustMarshal())
+		require.NoError(t, err)
+		require.Equal(t, fp.KeyName, record.Name)
+	})
+}
@@ -1,7 +1,10 @@
 package service
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -26,68 +29,103 @@ var (
 const (
 	// TODO: Maybe configurable?
 	maxFailedCycles = 20
-)
 
-type skipHeightRequest struct {
-	height uint64
-	resp   chan *skipHeightResponse
-}
+	// newBlockResubscribeInterval is the interval of subscribing to the new
+	// blocks again while the subscription is not available
+	newBlockResubscribeInterval = 10 * time.Second
 
-type skipHeightResponse struct {
-	err error
-}
+	// maxCachedBlocks is the maximum number of the retrieved blocks kept for
+	// the subscriptions that are behind the others
+	maxCachedBlocks = 1000
+)
 
+// ChainPoller retrieves the blocks of the consumer chain once and broadcasts
+// them in order to the subscriptions of the finality-provider instances
 type ChainPoller struct {
 	isStarted *atomic.Bool
 	wg        sync.WaitGroup
 	quit      chan struct{}
-
-	cc             clientcontroller.ClientController
-	cfg            *cfg.ChainPollerConfig
-	metrics        *metrics.FpMetrics
-	blockInfoChan  chan *types.BlockInfo
-	skipHeightChan chan *skipHeightRequest
-	nextHeight     uint64
-	logger         *zap.Logger
+	// ctx is cancelled once the poller is stopped to abort the retries
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	cc         clientcontroller.ClientController
+	verifier   HeaderVerifier
+	subscriber clientcontroller.BlockSubscriber
+	cfg        *cfg.ChainPollerConfig
+	metrics    *metrics.FpMetrics
+	logger     *zap.Logger
+
+	mu              sync.Mutex
+	subs            map[*PollerSubscription]struct{}
+	activatedHeight uint64
+
+	// recentBlocks caches the retrieved blocks for the subscriptions
+	// that are behind the others, and the pages of blocks while catching up.
+	// It is only accessed by the polling goroutine, as is catchingUp
+	recentBlocks map[uint64]*types.BlockInfo
+	catchingUp   bool
+
+	// wakeChan is notified once there might be blocks to deliver, i.e., a new
+	// block is produced, or a subscription is added, skips heights, or has room
+	// in its buffer again
+	wakeChan chan struct{}
+	// tipHeight is the highest height known to be produced
+	tipHeight *atomic.Uint64
+	// isSubscribed is whether the new blocks are notified by the subscriber,
+	// otherwise the tip is queried to detect whether the poller is behind
+	isSubscribed *atomic.Bool
 }
 
+// NewChainPoller returns a poller of the blocks of the consumer chain, which only
+// emits the blocks verified by the verifier unless the verifier is nil. The blocks
+// are retrieved as soon as the subscriber notifies that they are produced, and
+// once every poll interval if the subscriber is nil or the subscription drops
 func NewChainPoller(
 	logger *zap.Logger,
 	cfg *cfg.ChainPollerConfig,
 	cc clientcontroller.ClientController,
+	verifier HeaderVerifier,
+	subscriber clientcontroller.BlockSubscriber,
 	metrics *metrics.FpMetrics,
 ) *ChainPoller {
+	ctx, cancel := context.WithCancel(context.Background())
+
 	return &ChainPoller{
-		isStarted:      atomic.NewBool(false),
-		logger:         logger,
-		cfg:            cfg,
-		cc:             cc,
-		metrics:        metrics,
-		blockInfoChan:  make(chan *types.BlockInfo, cfg.BufferSize),
-		skipHeightChan: make(chan *skipHeightRequest),
-		quit:           make(chan struct{}),
+		ctx:          ctx,
+		cancel:       cancel,
+		isStarted:    atomic.NewBool(false),
+		logger:       logger,
+		cfg:          cfg,
+		cc:           cc,
+		verifier:     verifier,
+		subscriber:   subscriber,
+		metrics:      metrics,
+		subs:         make(map[*PollerSubscription]struct{}),
+		recentBlocks: make(map[uint64]*types.BlockInfo),
+		wakeChan:     make(chan struct{}, 1),
+		tipHeight:    atomic.NewUint64(0),
+		isSubscribed: atomic.NewBool(false),
+		quit:         make(chan struct{}),
 	}
 }
 
-func (cp *ChainPoller) Start(startHeight uint64) error {
+func (cp *ChainPoller) Start() error {
 	if cp.isStarted.Swap(true) {
 		return fmt.Errorf("the poller is already started")
 	}
 
 	cp.logger.Info("starting the chain poller")
 
-	err := cp.validateStartHeight(startHeight)
-	if err != nil {
-		return fmt.Errorf("invalid starting height %d: %w", startHeight, err)
-	}
-
-	cp.nextHeight = startHeight
-
 	cp.wg.Add(1)
 
 	go cp.pollChain()
 
-	cp.metrics.RecordPollerStartingHeight(startHeight)
+	if cp.subscriber != nil {
+		cp.wg.Add(1)
+		go cp.subscribeNewBlocks()
+	}
+
 	cp.logger.Info("the chain poller is successfully started")
 
 	return nil
@@ -99,11 +137,8 @@ func (cp *ChainPoller) Stop() error {
 	}
 
 	cp.logger.Info("stopping the chain poller")
-	err := cp.cc.Close()
-	if err != nil {
-		return err
-	}
 	close(cp.quit)
+	cp.cancel()
 	cp.wg.Wait()
 
 	cp.logger.Info("the chain poller is successfully stopped")
@@ -115,11 +150,61 @@ func (cp *ChainPoller) IsRunning() bool {
 	return cp.isStarted.Load()
 }
 
-// Return read only channel for incoming blocks
-// TODO: Handle the case when there is more than one consumer. Currently with more than
-// one consumer blocks most probably will be received out of order to those consumers.
-func (cp *ChainPoller) GetBlockInfoChan() <-chan *types.BlockInfo {
-	return cp.blockInfoChan
+// Subscribe returns a subscription receiving the blocks in order from the
+// start height, which proceeds independently of the other subscriptions
+func (cp *ChainPoller) Subscribe(startHeight uint64) (*PollerSubscription, error) {
+	if !cp.IsRunning() {
+		return nil, fmt.Errorf("the chain poller is stopped")
+	}
+
+	err := cp.validateStartHeight(startHeight)
+	if err != nil {
+		return nil, fmt.Errorf("invalid starting height %d: %w", startHeight, err)
+	}
+
+	s := newPollerSubscription(cp, startHeight)
+
+	cp.mu.Lock()
+	// ensure that the start height is no lower than the activated height
+	if s.nextHeight < cp.activatedHeight {
+		s.nextHeight = cp.activatedHeight
+	}
+	cp.subs[s] = struct{}{}
+	cp.mu.Unlock()
+
+	s.wg.Add(1)
+	go s.forwardBlocks()
+
+	cp.wakeUp()
+
+	cp.metrics.RecordPollerStartingHeight(startHeight)
+
+	return s, nil
+}
+
+func (cp *ChainPoller) unsubscribe(s *PollerSubscription) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	delete(cp.subs, s)
+}
+
+// checkServing returns an error if the poller is stopped
+func (cp *ChainPoller) checkServing() error {
+	if !cp.IsRunning() {
+		return fmt.Errorf("the chain poller is stopped")
+	}
+
+	return nil
+}
+
+// wakeUp notifies the polling goroutine that there might be blocks to deliver
+func (cp *ChainPoller) wakeUp() {
+	// a pending notification also covers this one
+	select {
+	case cp.wakeChan <- struct{}{}:
+	default:
+	}
 }
 
 func (cp *ChainPoller) latestBlockWithRetry() (*types.BlockInfo, error) {
@@ -134,7 +219,7 @@ func (cp *ChainPoller) latestBlockWithRetry() (*types.BlockInfo, error) {
 			return err
 		}
 		return nil
-	}, RtyAtt, RtyDel, RtyErr, retry.OnRetry(func(n uint, err error) {
+	}, retry.Context(cp.ctx), RtyAtt, RtyDel, RtyErr, retry.OnRetry(func(n uint, err error) {
 		cp.logger.Debug(
 			"failed to query the consumer chain for the latest block",
 			zap.Uint("attempt", n+1),
@@ -158,7 +243,10 @@ func (cp *ChainPoller) blockWithRetry(height uint64) (*types.BlockInfo, error) {
 			return err
 		}
 		return nil
-	}, RtyAtt, RtyDel, RtyErr, retry.OnRetry(func(n uint, err error) {
+	}, retry.Context(cp.ctx), RtyAtt, RtyDel, RtyErr, retry.RetryIf(func(err error) bool {
+		// the disagreement of the rpc servers is not transient
+		return !errors.Is(err, clientcontroller.ErrBlockHashMismatch)
+	}), retry.OnRetry(func(n uint, err error) {
 		cp.logger.Debug(
 			"failed to query the consumer chain for the latest block",
 			zap.Uint("attempt", n+1),
@@ -173,25 +261,50 @@ func (cp *ChainPoller) blockWithRetry(height uint64) (*types.BlockInfo, error) {
 	return block, nil
 }
 
-func (cp *ChainPoller) validateStartHeight(startHeight uint64) error {
-	// Infinite retry to get initial latest height
-	// TODO: Add possible cancellation or timeout for starting node
+func (cp *ChainPoller) blocksWithRetry(startHeight, endHeight, limit uint64) ([]*types.BlockInfo, error) {
+	var (
+		blocks []*types.BlockInfo
+		err    error
+	)
+	if err := retry.Do(func() error {
+		blocks, err = cp.cc.QueryBlocks(startHeight, endHeight, limit)
+		if err != nil {
+			return err
+		}
+		return nil
+	}, retry.Context(cp.ctx), RtyAtt, RtyDel, RtyErr, retry.RetryIf(func(err error) bool {
+		// the disagreement of the rpc servers is not transient
+		return !errors.Is(err, clientcontroller.ErrBlockHashMismatch)
+	}), retry.OnRetry(func(n uint, err error) {
+		cp.logger.Debug(
+			"failed to query the consumer chain for the blocks",
+			zap.Uint("attempt", n+1),
+			zap.Uint("max_attempts", RtyAttNum),
+			zap.Uint64("start_height", startHeight),
+			zap.Uint64("end_height", endHeight),
+			zap.Error(err),
+		)
+	})); err != nil {
+		return nil, err
+	}
 
+	return blocks, nil
+}
+
+// validateStartHeight checks the start height against the chain tip. It gives
+// up once the retries are exhausted or the poller is stopped, so that the
+// caller, e.g., a restarting finality-provider instance, can back off
+func (cp *ChainPoller) validateStartHeight(startHeight uint64) error {
 	if startHeight == 0 {
 		return fmt.Errorf("start height can't be 0")
 	}
 
-	var currentBestChainHeight uint64
-	for {
-		lastestBlock, err := cp.latestBlockWithRetry()
-		if err != nil {
-			cp.logger.Debug("failed to query babylon for the latest status", zap.Error(err))
-			continue
-		}
-
-		currentBestChainHeight = lastestBlock.Height
-		break
+	lastestBlock, err := cp.latestBlockWithRetry()
+	if err != nil {
+		return fmt.Errorf("failed to query the consumer chain for the latest block: %w", err)
 	}
+	currentBestChainHeight := lastestBlock.Height
+	cp.observeTip(currentBestChainHeight)
 
 	// Allow the start height to be the next chain height
 	if startHeight > currentBestChainHeight+1 {
@@ -209,9 +322,12 @@ func (cp *ChainPoller) waitForActivation() {
 		if err != nil {
 			cp.logger.Debug("failed to query the consumer chain for the activated height", zap.Error(err))
 		} else {
-			if cp.nextHeight < activatedHeight {
-				cp.nextHeight = activatedHeight
+			cp.mu.Lock()
+			cp.activatedHeight = activatedHeight
+			for s := range cp.subs {
+				s.raiseNextHeight(activatedHeight)
 			}
+			cp.mu.Unlock()
 			return
 		}
 
@@ -231,107 +347,354 @@ func (cp *ChainPoller) pollChain() {
 	cp.waitForActivation()
 
 	var failedCycles uint32
+	// the blocks beyond the tip are polled once every poll interval, while the
+	// blocks known to be produced are retrieved once there is room for them
+	nextPollTime := time.Now()
 
 	for {
-		// TODO: Handlig of request cancellation, as otherwise shutdown will be blocked
-		// until request is finished
-		blockToRetrieve := cp.nextHeight
-		block, err := cp.blockWithRetry(blockToRetrieve)
-		if err != nil {
-			failedCycles++
-			cp.logger.Debug(
-				"failed to query the consumer chain for the block",
-				zap.Uint32("current_failures", failedCycles),
-				zap.Uint64("block_to_retrieve", blockToRetrieve),
-				zap.Error(err),
-			)
-		} else {
-			// no error and we got the header we wanted to get, bump the state and push
-			// notification about data
-			cp.nextHeight = blockToRetrieve + 1
-			failedCycles = 0
-			cp.metrics.RecordLastPolledHeight(block.Height)
-
-			cp.logger.Info("the poller retrieved the block from the consumer chain",
-				zap.Uint64("height", block.Height))
-
-			// push the data to the channel
-			// Note: if the consumer is too slow -- the buffer is full
-			// the channel will block, and we will stop retrieving data from the node
-			cp.blockInfoChan <- block
+		retrieveNext := false
+
+		// retrieve the lowest block that any subscription is ready to receive
+		// so that each block is only retrieved once for all the subscriptions
+		blockToRetrieve, ok := cp.lowestHeightToDeliver()
+		// the block beyond the tip is likely not produced yet, so failing to
+		// retrieve it is neither retried nor counted as a failed cycle, while
+		// the poller keeps serving the subscriptions that are behind
+		beyondTip := blockToRetrieve > cp.tipHeight.Load()
+		if ok && (!beyondTip || !time.Now().Before(nextPollTime)) {
+			cp.updateMode(blockToRetrieve)
+
+			// TODO: Handlig of request cancellation, as otherwise shutdown will be blocked
+			// until the ongoing request is finished
+			block, err := cp.retrieveBlock(blockToRetrieve, beyondTip)
+			if errors.Is(err, clientcontroller.ErrBlockHashMismatch) || errors.Is(err, ErrUnverifiedBlock) {
+				// the block is retrieved again once a new subscription
+				// expects it, e.g., the finality-provider is restarted
+				cp.logger.Error("the block cannot be trusted, halting the subscriptions expecting it",
+					zap.Uint64("height", blockToRetrieve), zap.Error(err))
+				cp.halt(blockToRetrieve, err)
+			} else if err != nil {
+				if !beyondTip {
+					failedCycles++
+				}
+				cp.logger.Debug(
+					"failed to retrieve the block from the consumer chain",
+					zap.Uint32("current_failures", failedCycles),
+					zap.Uint64("block_to_retrieve", blockToRetrieve),
+					zap.Error(err),
+				)
+			} else {
+				failedCycles = 0
+				cp.deliver(blockToRetrieve, block)
+
+				// retrieve the next block without waiting if it is known to be produced
+				next, ok := cp.lowestHeightToDeliver()
+				retrieveNext = ok && cp.isProduced(next)
+			}
+
+			// the subscriptions are notified while the poller keeps retrying,
+			// so that the finality-provider instances can be restarted
+			if failedCycles > maxFailedCycles {
+				cp.logger.Error("the poller has reached the max failed cycles",
+					zap.Uint64("block_to_retrieve", blockToRetrieve), zap.Error(err))
+				cp.reportErr(fmt.Errorf("%w: failed to retrieve the block at height %d: %v",
+					ErrPollerUnavailable, blockToRetrieve, err))
+				failedCycles = 0
+			}
 		}
 
-		if failedCycles > maxFailedCycles {
-			cp.logger.Fatal("the poller has reached the max failed cycles, exiting")
+		waitTime := time.Until(nextPollTime)
+		if waitTime <= 0 {
+			waitTime = cp.cfg.PollInterval
+			nextPollTime = time.Now().Add(waitTime)
+		}
+		if retrieveNext {
+			waitTime = 0
 		}
 
 		select {
-		case <-time.After(cp.cfg.PollInterval):
+		case <-time.After(waitTime):
 
-		case req := <-cp.skipHeightChan:
-			// no need to skip heights if the target height is not higher
-			// than the next height to retrieve
-			targetHeight := req.height
-			if targetHeight <= cp.nextHeight {
-				resp := &skipHeightResponse{
-					err: fmt.Errorf(
-						"the target height %d is not higher than the next height %d to retrieve",
-						targetHeight, cp.nextHeight)}
-				req.resp <- resp
-				continue
-			}
+		case <-cp.wakeChan:
 
-			// drain blocks that can be skipped from blockInfoChan
-			cp.clearChanBufferUpToHeight(targetHeight)
+		case <-cp.quit:
+			return
+		}
+	}
+}
 
-			// set the next height to the skip height
-			cp.nextHeight = targetHeight
+// lowestHeightToDeliver returns the lowest next height of the subscriptions
+// that have room in their buffers, or false if there is no such subscription
+func (cp *ChainPoller) lowestHeightToDeliver() (uint64, bool) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
 
-			cp.logger.Debug("the poller has skipped height(s)",
-				zap.Uint64("next_height", req.height))
+	var (
+		lowest uint64
+		found  bool
+	)
+	for s := range cp.subs {
+		next, ready := s.readyHeight()
+		if ready && (!found || next < lowest) {
+			lowest = next
+			found = true
+		}
+	}
 
-			req.resp <- &skipHeightResponse{}
+	return lowest, found
+}
 
-		case <-cp.quit:
+// observeTip records the height that is known to be produced
+func (cp *ChainPoller) observeTip(height uint64) {
+	for {
+		tip := cp.tipHeight.Load()
+		if height <= tip || cp.tipHeight.CompareAndSwap(tip, height) {
 			return
 		}
 	}
 }
 
-func (cp *ChainPoller) SkipToHeight(height uint64) error {
-	if !cp.IsRunning() {
-		return fmt.Errorf("the chain poller is stopped")
+// isProduced returns whether the block at the given height is known to be
+// produced. The tip is queried if the height is not known to be produced
+// while the new blocks are not notified, so that the poller detects that
+// it is behind the tip
+func (cp *ChainPoller) isProduced(height uint64) bool {
+	if height <= cp.tipHeight.Load() {
+		return true
+	}
+	if cp.isSubscribed.Load() {
+		return false
 	}
 
-	respChan := make(chan *skipHeightResponse, 1)
-
-	// this handles the case when the poller is stopped before the
-	// skip height request is sent
-	select {
-	case <-cp.quit:
-		return fmt.Errorf("the chain poller is stopped")
-	case cp.skipHeightChan <- &skipHeightRequest{height: height, resp: respChan}:
+	latestBlock, err := cp.cc.QueryBestBlock()
+	if err != nil {
+		cp.logger.Debug("failed to query the consumer chain for the latest block", zap.Error(err))
+		return false
 	}
+	cp.observeTip(latestBlock.Height)
 
-	// this handles the case when the poller is stopped before
-	// the skip height request is returned
-	select {
-	case <-cp.quit:
-		return fmt.Errorf("the chain poller is stopped")
-	case resp := <-respChan:
-		return resp.err
+	return height <= latestBlock.Height
+}
+
+// updateMode switches to the catch-up mode once the next height to retrieve
+// is at least CatchUpThreshold blocks behind the tip, and switches back to
+// following the tip once all the produced blocks are retrieved
+func (cp *ChainPoller) updateMode(nextHeight uint64) {
+	var lag uint64
+	if tip := cp.tipHeight.Load(); tip >= nextHeight {
+		lag = tip - nextHeight + 1
 	}
+	cp.metrics.RecordPollerLag(lag)
+
+	switch {
+	case !cp.catchingUp && cp.cfg.CatchUpThreshold > 0 && lag >= cp.cfg.CatchUpThreshold:
+		cp.catchingUp = true
+		cp.logger.Info("the poller is behind the tip, catching up",
+			zap.Uint64("next_height", nextHeight), zap.Uint64("lag", lag))
+	case cp.catchingUp && lag == 0:
+		cp.catchingUp = false
+		cp.logger.Info("the poller has caught up with the tip, following the tip",
+			zap.Uint64("next_height", nextHeight))
+	}
+	cp.metrics.RecordPollerMode(cp.catchingUp)
 }
 
-func (cp *ChainPoller) NextHeight() uint64 {
-	return cp.nextHeight
+// retrieveBlock returns the block at the given height from the cache,
+// or retrieves it from the consumer chain and verifies it. While catching
+// up, the blocks from the given height are retrieved in a page
+func (cp *ChainPoller) retrieveBlock(height uint64, beyondTip bool) (*types.BlockInfo, error) {
+	if block, ok := cp.recentBlocks[height]; ok {
+		return block, nil
+	}
+
+	if cp.catchingUp {
+		// the blocks of the page below a block failing to be
+		// retrieved or verified are still cached
+		err := cp.retrieveBlockPage(height)
+		if block, ok := cp.recentBlocks[height]; ok {
+			return block, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		block *types.BlockInfo
+		err   error
+	)
+	if beyondTip {
+		block, err = cp.cc.QueryBlock(height)
+	} else {
+		block, err = cp.blockWithRetry(height)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cp.verifier != nil {
+		if err := cp.verifier.VerifyBlock(block); err != nil {
+			return nil, err
+		}
+	}
+
+	cp.metrics.RecordLastPolledHeight(block.Height)
+	cp.logger.Info("the poller retrieved the block from the consumer chain",
+		zap.Uint64("height", block.Height))
+
+	cp.observeTip(height)
+	cp.cacheBlock(height, block)
+
+	return block, nil
 }
 
-func (cp *ChainPoller) clearChanBufferUpToHeight(upToHeight uint64) {
-	for len(cp.blockInfoChan) > 0 {
-		block := <-cp.blockInfoChan
-		if block.Height+1 >= upToHeight {
+// retrieveBlockPage retrieves and verifies the consecutive blocks from the
+// given height up to the tip in a page, and caches them until they are
+// delivered to the subscriptions
+func (cp *ChainPoller) retrieveBlockPage(startHeight uint64) error {
+	pageSize := cp.cfg.CatchUpPageSize
+	if pageSize > maxCachedBlocks {
+		pageSize = maxCachedBlocks
+	}
+	endHeight := startHeight + pageSize - 1
+	if tip := cp.tipHeight.Load(); tip < endHeight {
+		endHeight = tip
+	}
+	if endHeight < startHeight {
+		return nil
+	}
+
+	blocks, err := cp.blocksWithRetry(startHeight, endHeight, pageSize)
+	if err != nil {
+		return err
+	}
+
+	for i, block := range blocks {
+		// only the consecutive blocks from the start height are cached
+		height := startHeight + uint64(i)
+		if block.Height != height {
 			break
 		}
+		if cp.verifier != nil {
+			if err := cp.verifier.VerifyBlock(block); err != nil {
+				return err
+			}
+		}
+
+		cp.metrics.RecordLastPolledHeight(height)
+		cp.observeTip(height)
+		cp.cacheBlock(height, block)
+	}
+
+	cp.logger.Info("the poller retrieved a page of blocks from the consumer chain",
+		zap.Uint64("start_height", startHeight), zap.Int("num_blocks", len(blocks)))
+
+	return nil
+}
+
+// cacheBlock keeps the block until all the subscriptions have received it,
+// while at most maxCachedBlocks blocks are kept by dropping the lowest ones
+func (cp *ChainPoller) cacheBlock(height uint64, block *types.BlockInfo) {
+	cp.recentBlocks[height] = block
+
+	lowest := cp.lowestNextHeight()
+	heights := make([]uint64, 0, len(cp.recentBlocks))
+	for h := range cp.recentBlocks {
+		if h < lowest {
+			delete(cp.recentBlocks, h)
+			continue
+		}
+		heights = append(heights, h)
+	}
+
+	if len(heights) > maxCachedBlocks {
+		sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+		for _, h := range heights[:len(heights)-maxCachedBlocks] {
+			delete(cp.recentBlocks, h)
+		}
+	}
+}
+
+// lowestNextHeight returns the lowest next height of all the subscriptions
+func (cp *ChainPoller) lowestNextHeight() uint64 {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	var (
+		lowest uint64
+		found  bool
+	)
+	for s := range cp.subs {
+		next := s.NextHeight()
+		if !found || next < lowest {
+			lowest = next
+			found = true
+		}
+	}
+
+	return lowest
+}
+
+// deliver pushes the block at the given height to the subscriptions expecting it
+func (cp *ChainPoller) deliver(height uint64, block *types.BlockInfo) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	for s := range cp.subs {
+		s.deliver(height, block)
+	}
+}
+
+// subscribeNewBlocks keeps subscribing to the new blocks so that they are
+// retrieved as soon as they are produced, while the poll interval is kept
+// as the fallback if the subscription is not available
+func (cp *ChainPoller) subscribeNewBlocks() {
+	defer cp.wg.Done()
+
+	for {
+		heights, err := cp.subscriber.SubscribeNewBlocks(cp.ctx)
+		if err != nil {
+			cp.logger.Debug("failed to subscribe to the new blocks, falling back to polling",
+				zap.Error(err))
+		} else {
+			cp.logger.Info("subscribed to the new blocks")
+			cp.isSubscribed.Store(true)
+			for h := range heights {
+				cp.observeTip(h)
+				cp.wakeUp()
+			}
+			cp.isSubscribed.Store(false)
+			if cp.ctx.Err() == nil {
+				cp.logger.Warn("the new block subscription dropped, falling back to polling")
+			}
+		}
+
+		select {
+		case <-time.After(newBlockResubscribeInterval):
+		case <-cp.quit:
+			return
+		}
+	}
+}
+
+// halt stops delivering blocks to the subscriptions expecting the block at
+// the given height, which cannot be trusted, after reporting the critical
+// error to them. The poller keeps serving the other subscriptions
+func (cp *ChainPoller) halt(height uint64, err error) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	for s := range cp.subs {
+		s.halt(height, err)
+	}
+}
+
+// reportErr reports the critical error to all the subscriptions
+// without halting the poller
+func (cp *ChainPoller) reportErr(err error) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	for s := range cp.subs {
+		s.reportCriticalErr(err)
 	}
 }
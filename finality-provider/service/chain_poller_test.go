@@ -1,8 +1,10 @@
 package service_test
 
 import (
+	"fmt"
 	"math/rand"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
+	"github.com/babylonlabs-io/finality-provider/clientcontroller"
 	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
 	"github.com/babylonlabs-io/finality-provider/finality-provider/service"
 	"github.com/babylonlabs-io/finality-provider/metrics"
@@ -50,8 +53,10 @@ func FuzzChainPoller_Start(f *testing.F) {
 		m := metrics.NewFpMetrics()
 		pollerCfg := fpcfg.DefaultChainPollerConfig()
 		pollerCfg.PollInterval = 10 * time.Millisecond
-		poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, mockClientController, m)
-		err := poller.Start(startHeight)
+		poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, mockClientController, nil, nil, m)
+		err := poller.Start()
+		require.NoError(t, err)
+		sub, err := poller.Subscribe(startHeight)
 		require.NoError(t, err)
 		defer func() {
 			err := poller.Stop()
@@ -60,7 +65,216 @@ func FuzzChainPoller_Start(f *testing.F) {
 
 		for i := startHeight; i <= endHeight; i++ {
 			select {
-			case info := <-poller.GetBlockInfoChan():
+			case info := <-sub.GetBlockInfoChan():
+				require.Equal(t, i, info.Height)
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Failed to get block info")
+			}
+		}
+	})
+}
+
+// FuzzChainPoller_MultipleSubscriptions tests the poller broadcasting each
+// block once retrieved to the subscriptions in order, while a subscription
+// that does not receive blocks does not block the others
+func FuzzChainPoller_MultipleSubscriptions(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		currentHeight := uint64(r.Int63n(100) + 1)
+		startHeight := currentHeight + 1
+		endHeight := startHeight + uint64(r.Int63n(10)+5)
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		mockClientController.EXPECT().Close().Return(nil).AnyTimes()
+		mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
+		mockClientController.EXPECT().QueryBestBlock().Return(&types.BlockInfo{Height: endHeight}, nil).AnyTimes()
+
+		// each block is only retrieved once for all the subscriptions
+		for i := startHeight; i <= endHeight; i++ {
+			resBlock := &types.BlockInfo{
+				Height: i,
+			}
+			mockClientController.EXPECT().QueryBlock(i).Return(resBlock, nil).Times(1)
+		}
+		mockClientController.EXPECT().QueryBlock(endHeight+1).Return(nil, fmt.Errorf("the block is not produced")).AnyTimes()
+
+		m := metrics.NewFpMetrics()
+		pollerCfg := fpcfg.DefaultChainPollerConfig()
+		pollerCfg.PollInterval = 10 * time.Millisecond
+		pollerCfg.BufferSize = 2
+		poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, mockClientController, nil, nil, m)
+		err := poller.Start()
+		require.NoError(t, err)
+		defer func() {
+			err := poller.Stop()
+			require.NoError(t, err)
+		}()
+
+		// the stalled subscription never receives blocks
+		stalledSub, err := poller.Subscribe(startHeight)
+		require.NoError(t, err)
+		sub, err := poller.Subscribe(startHeight)
+		require.NoError(t, err)
+		for i := startHeight; i <= endHeight; i++ {
+			select {
+			case info := <-sub.GetBlockInfoChan():
+				require.Equal(t, i, info.Height)
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Failed to get block info")
+			}
+		}
+
+		// the late subscription receives the blocks from its own start height,
+		// which are kept by the poller as the stalled subscription is behind
+		stalledHeight := stalledSub.NextHeight()
+		lateStartHeight := stalledHeight + uint64(r.Int63n(int64(endHeight-stalledHeight+1)))
+		lateSub, err := poller.Subscribe(lateStartHeight)
+		require.NoError(t, err)
+		for i := lateStartHeight; i <= endHeight; i++ {
+			select {
+			case info := <-lateSub.GetBlockInfoChan():
+				require.Equal(t, i, info.Height)
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Failed to get block info")
+			}
+		}
+
+		// the stalled subscription resumes from where it stopped
+		for i := startHeight; i <= endHeight; i++ {
+			select {
+			case info := <-stalledSub.GetBlockInfoChan():
+				require.Equal(t, i, info.Height)
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Failed to get block info")
+			}
+		}
+
+		require.NoError(t, lateSub.Close())
+		require.Error(t, lateSub.SkipToHeight(endHeight+1))
+	})
+}
+
+// FuzzChainPoller_CatchUp tests the poller retrieving the blocks in pages
+// once it is far behind the tip, and following the tip once caught up
+func FuzzChainPoller_CatchUp(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		catchUpThreshold := uint64(r.Int63n(10) + 1)
+		pageSize := uint64(r.Int63n(10) + 1)
+		startHeight := uint64(r.Int63n(100) + 1)
+		tipHeight := startHeight + catchUpThreshold + uint64(r.Int63n(30))
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		mockClientController.EXPECT().Close().Return(nil).AnyTimes()
+		mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
+		mockClientController.EXPECT().QueryBestBlock().Return(&types.BlockInfo{Height: tipHeight}, nil).AnyTimes()
+		// the blocks up to the tip are only retrieved in pages
+		mockClientController.EXPECT().QueryBlocks(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(startHeight, endHeight, limit uint64) ([]*types.BlockInfo, error) {
+				require.LessOrEqual(t, endHeight, tipHeight)
+				require.Equal(t, pageSize, limit)
+				var blocks []*types.BlockInfo
+				for h := startHeight; h <= endHeight && uint64(len(blocks)) < limit; h++ {
+					blocks = append(blocks, &types.BlockInfo{Height: h})
+				}
+				return blocks, nil
+			}).AnyTimes()
+		mockClientController.EXPECT().QueryBlock(tipHeight+1).Return(&types.BlockInfo{Height: tipHeight + 1}, nil).AnyTimes()
+		mockClientController.EXPECT().QueryBlock(tipHeight+2).Return(nil, fmt.Errorf("the block is not produced")).AnyTimes()
+
+		m := metrics.NewFpMetrics()
+		pollerCfg := fpcfg.DefaultChainPollerConfig()
+		pollerCfg.PollInterval = 10 * time.Millisecond
+		pollerCfg.CatchUpThreshold = catchUpThreshold
+		pollerCfg.CatchUpPageSize = pageSize
+		poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, mockClientController, nil, nil, m)
+		err := poller.Start()
+		require.NoError(t, err)
+		defer func() {
+			err := poller.Stop()
+			require.NoError(t, err)
+		}()
+		sub, err := poller.Subscribe(startHeight)
+		require.NoError(t, err)
+
+		// the block after the tip is retrieved once it is produced
+		for i := startHeight; i <= tipHeight+1; i++ {
+			select {
+			case info := <-sub.GetBlockInfoChan():
+				require.Equal(t, i, info.Height)
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Failed to get block info")
+			}
+		}
+	})
+}
+
+// FuzzChainPoller_NewBlockSubscription tests the poller retrieving blocks
+// once they are notified by the subscription rather than polling
+func FuzzChainPoller_NewBlockSubscription(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		currentHeight := uint64(r.Int63n(100) + 1)
+		startHeight := currentHeight + 1
+		endHeight := startHeight + uint64(r.Int63n(10)+1)
+
+		var producedHeight atomic.Uint64
+		producedHeight.Store(startHeight)
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		mockClientController.EXPECT().Close().Return(nil).AnyTimes()
+		mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
+		mockClientController.EXPECT().QueryBestBlock().Return(&types.BlockInfo{Height: currentHeight}, nil).AnyTimes()
+		mockClientController.EXPECT().QueryBlock(gomock.Any()).DoAndReturn(func(height uint64) (*types.BlockInfo, error) {
+			if height > producedHeight.Load() {
+				return nil, fmt.Errorf("the block at height %d is not produced", height)
+			}
+			return &types.BlockInfo{Height: height}, nil
+		}).AnyTimes()
+
+		server := testutil.NewNewBlockEventServer(t)
+		subscriber, err := clientcontroller.NewCometBFTBlockSubscriber(server.RPCAddr(), zap.NewNop())
+		require.NoError(t, err)
+
+		m := metrics.NewFpMetrics()
+		pollerCfg := fpcfg.DefaultChainPollerConfig()
+		// the blocks are not retrieved by polling within the test
+		pollerCfg.PollInterval = time.Hour
+		poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, mockClientController, nil, subscriber, m)
+		err = poller.Start()
+		require.NoError(t, err)
+		sub, err := poller.Subscribe(startHeight)
+		require.NoError(t, err)
+		defer func() {
+			err := poller.Stop()
+			require.NoError(t, err)
+		}()
+
+		select {
+		case info := <-sub.GetBlockInfoChan():
+			require.Equal(t, startHeight, info.Height)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("Failed to get block info")
+		}
+
+		server.WaitForSubscribers(t, 1)
+		producedHeight.Store(endHeight)
+		for i := startHeight + 1; i <= endHeight; i++ {
+			server.PublishNewBlock(t, int64(i))
+		}
+
+		for i := startHeight + 1; i <= endHeight; i++ {
+			select {
+			case info := <-sub.GetBlockInfoChan():
 				require.Equal(t, i, info.Height)
 			case <-time.After(10 * time.Second):
 				t.Fatalf("Failed to get block info")
@@ -96,22 +310,26 @@ func FuzzChainPoller_SkipHeight(f *testing.F) {
 			}
 			mockClientController.EXPECT().QueryBlock(i).Return(resBlock, nil).AnyTimes()
 		}
+		// the poller might be woken up to retrieve the next block right after skipping
+		mockClientController.EXPECT().QueryBlock(skipHeight+1).Return(nil, fmt.Errorf("the block is not produced")).AnyTimes()
 
 		// TODO: use mock metrics
 		m := metrics.NewFpMetrics()
 		pollerCfg := fpcfg.DefaultChainPollerConfig()
 		pollerCfg.PollInterval = 1 * time.Second
-		poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, mockClientController, m)
+		poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, mockClientController, nil, nil, m)
 		// should expect error if the poller is not started
-		err := poller.SkipToHeight(skipHeight)
+		_, err := poller.Subscribe(startHeight)
 		require.Error(t, err)
-		err = poller.Start(startHeight)
+		err = poller.Start()
+		require.NoError(t, err)
+		sub, err := poller.Subscribe(startHeight)
 		require.NoError(t, err)
 		defer func() {
 			err := poller.Stop()
 			require.NoError(t, err)
 			// should expect error if the poller is stopped
-			err = poller.SkipToHeight(skipHeight)
+			err = sub.SkipToHeight(skipHeight)
 			require.Error(t, err)
 		}()
 
@@ -121,11 +339,11 @@ func FuzzChainPoller_SkipHeight(f *testing.F) {
 			wg.Done()
 			// insert a skipToHeight request with height lower than the next
 			// height to retrieve, expecting an error
-			err = poller.SkipToHeight(poller.NextHeight() - 1)
+			err = sub.SkipToHeight(sub.NextHeight() - 1)
 			require.Error(t, err)
 			// insert a skipToHeight request with a height higher than the
 			// next height to retrieve
-			err = poller.SkipToHeight(skipHeight)
+			err = sub.SkipToHeight(skipHeight)
 			require.NoError(t, err)
 		}()
 
@@ -135,7 +353,7 @@ func FuzzChainPoller_SkipHeight(f *testing.F) {
 				break
 			}
 			select {
-			case info := <-poller.GetBlockInfoChan():
+			case info := <-sub.GetBlockInfoChan():
 				if info.Height == skipHeight {
 					skipped = true
 				} else {
@@ -148,6 +366,326 @@ func FuzzChainPoller_SkipHeight(f *testing.F) {
 
 		wg.Wait()
 
-		require.Equal(t, skipHeight+1, poller.NextHeight())
+		require.Equal(t, skipHeight+1, sub.NextHeight())
+	})
+}
+
+// FuzzChainPoller_SkipHeightRace tests that no block lower than the height
+// skipped to is received, including the ones already taken from the buffer
+// by the forwarding goroutine while the subscriber is not receiving
+func FuzzChainPoller_SkipHeightRace(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		startHeight := uint64(r.Int63n(100) + 1)
+		endHeight := startHeight + uint64(r.Int63n(50)+10)
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		mockClientController.EXPECT().Close().Return(nil).AnyTimes()
+		mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
+		mockClientController.EXPECT().QueryBestBlock().Return(&types.BlockInfo{Height: endHeight}, nil).AnyTimes()
+		mockClientController.EXPECT().QueryBlock(gomock.Any()).DoAndReturn(func(height uint64) (*types.BlockInfo, error) {
+			if height > endHeight {
+				return nil, fmt.Errorf("the block is not produced")
+			}
+			return &types.BlockInfo{Height: height}, nil
+		}).AnyTimes()
+
+		// TODO: use mock metrics
+		m := metrics.NewFpMetrics()
+		pollerCfg := fpcfg.DefaultChainPollerConfig()
+		pollerCfg.PollInterval = 10 * time.Millisecond
+		pollerCfg.BufferSize = uint32(r.Int63n(3) + 1)
+		poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, mockClientController, nil, nil, m)
+		require.NoError(t, poller.Start())
+		defer func() {
+			require.NoError(t, poller.Stop())
+		}()
+		sub, err := poller.Subscribe(startHeight)
+		require.NoError(t, err)
+
+		receivedHeight := startHeight - 1
+		for receivedHeight < endHeight-1 {
+			// let the poller fill the buffer, and the forwarding goroutine
+			// take a block from it, while the subscriber is not receiving
+			if r.Intn(2) == 0 {
+				time.Sleep(time.Millisecond)
+			}
+
+			skipHeight := receivedHeight + 2 + uint64(r.Int63n(int64(endHeight-receivedHeight-1)))
+			// the skip is refused if the blocks up to the height are buffered
+			skipped := sub.SkipToHeight(skipHeight) == nil
+
+			select {
+			case info := <-sub.GetBlockInfoChan():
+				if skipped {
+					require.Equal(t, skipHeight, info.Height)
+				} else {
+					require.Equal(t, receivedHeight+1, info.Height)
+				}
+				receivedHeight = info.Height
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Failed to get block info")
+			}
+		}
+	})
+}
+
+// FuzzChainPoller_CrossVerify tests that the poller halts the subscriptions
+// expecting a block that the rpc servers disagree on after reporting a critical
+// error, while it keeps serving the other subscriptions, and the block is
+// retrieved again for a new subscription
+func FuzzChainPoller_CrossVerify(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		currentHeight := uint64(r.Int63n(100) + 1)
+		startHeight := currentHeight + 1
+		forkHeight := startHeight + uint64(r.Int63n(10))
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		mockClientController.EXPECT().Close().Return(nil).AnyTimes()
+		mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
+		mockClientController.EXPECT().QueryBestBlock().Return(&types.BlockInfo{Height: currentHeight}, nil).AnyTimes()
+		mockVerifier := mocks.NewMockClientController(ctl)
+		mockVerifier.EXPECT().Close().Return(nil).AnyTimes()
+
+		// the verifier serves a fork from the fork height until it is healed
+		var forked atomic.Bool
+		forked.Store(true)
+		for i := startHeight; i <= forkHeight+1; i++ {
+			resBlock := &types.BlockInfo{
+				Height: i,
+				Hash:   testutil.GenRandomByteArray(r, 32),
+			}
+			mockClientController.EXPECT().QueryBlock(i).Return(resBlock, nil).AnyTimes()
+			if i < forkHeight {
+				mockVerifier.EXPECT().QueryBlock(i).Return(resBlock, nil).AnyTimes()
+			} else {
+				forkBlock := &types.BlockInfo{
+					Height: i,
+					Hash:   testutil.GenRandomByteArray(r, 32),
+				}
+				mockVerifier.EXPECT().QueryBlock(i).DoAndReturn(func(_ uint64) (*types.BlockInfo, error) {
+					if forked.Load() {
+						return forkBlock, nil
+					}
+					return resBlock, nil
+				}).AnyTimes()
+			}
+		}
+		cc, err := clientcontroller.NewCrossVerifiedClientController(
+			mockClientController,
+			[]*clientcontroller.BlockVerifier{{Addr: "verifier", CC: mockVerifier}},
+			2,
+			zap.NewNop(),
+		)
+		require.NoError(t, err)
+
+		m := metrics.NewFpMetrics()
+		pollerCfg := fpcfg.DefaultChainPollerConfig()
+		pollerCfg.PollInterval = 10 * time.Millisecond
+		poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, cc, nil, nil, m)
+		err = poller.Start()
+		require.NoError(t, err)
+		sub, err := poller.Subscribe(startHeight)
+		require.NoError(t, err)
+		defer func() {
+			err := poller.Stop()
+			require.NoError(t, err)
+		}()
+
+		for i := startHeight; i < forkHeight; i++ {
+			select {
+			case info := <-sub.GetBlockInfoChan():
+				require.Equal(t, i, info.Height)
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Failed to get block info")
+			}
+		}
+
+		select {
+		case err := <-sub.GetCriticalErrChan():
+			require.ErrorIs(t, err, clientcontroller.ErrBlockHashMismatch)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("Failed to get the critical error")
+		}
+
+		// no block is emitted from the fork height
+		select {
+		case info := <-sub.GetBlockInfoChan():
+			t.Fatalf("Unexpected block at height %d", info.Height)
+		default:
+		}
+		require.Equal(t, forkHeight, sub.NextHeight())
+		require.Error(t, sub.SkipToHeight(forkHeight+1))
+
+		// the poller keeps serving a subscription behind the halted one,
+		// which is halted once it expects the block of the fork height
+		lateSub, err := poller.Subscribe(startHeight)
+		require.NoError(t, err)
+		for i := startHeight; i < forkHeight; i++ {
+			select {
+			case info := <-lateSub.GetBlockInfoChan():
+				require.Equal(t, i, info.Height)
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Failed to get block info")
+			}
+		}
+		select {
+		case err := <-lateSub.GetCriticalErrChan():
+			require.ErrorIs(t, err, clientcontroller.ErrBlockHashMismatch)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("Failed to get the critical error")
+		}
+
+		// the block is retrieved again for a new subscription, e.g.,
+		// a restarted finality provider, once the rpc servers agree
+		require.NoError(t, sub.Close())
+		require.NoError(t, lateSub.Close())
+		forked.Store(false)
+		restartedSub, err := poller.Subscribe(startHeight)
+		require.NoError(t, err)
+		for i := startHeight; i <= forkHeight; i++ {
+			select {
+			case info := <-restartedSub.GetBlockInfoChan():
+				require.Equal(t, i, info.Height)
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Failed to get block info")
+			}
+		}
 	})
 }
+
+// testHeaderVerifier fails to verify each block once, and rejects
+// the blocks from the forged height
+type testHeaderVerifier struct {
+	mu           sync.Mutex
+	forgedHeight uint64
+	failed       map[uint64]bool
+}
+
+func (v *testHeaderVerifier) VerifyBlock(b *types.BlockInfo) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if b.Height >= v.forgedHeight {
+		return fmt.Errorf("%w: the block at height %d is forged", service.ErrUnverifiedBlock, b.Height)
+	}
+	if !v.failed[b.Height] {
+		v.failed[b.Height] = true
+		return fmt.Errorf("failed to fetch the header at height %d", b.Height)
+	}
+
+	return nil
+}
+
+func (v *testHeaderVerifier) hasFailed(height uint64) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return v.failed[height]
+}
+
+// FuzzChainPoller_VerifyHeader tests that the poller only emits the verified
+// blocks, and halts once a block is proven not to match the verified header
+func FuzzChainPoller_VerifyHeader(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		currentHeight := uint64(r.Int63n(100) + 1)
+		startHeight := currentHeight + 1
+		forgedHeight := startHeight + uint64(r.Int63n(5))
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		mockClientController.EXPECT().Close().Return(nil).AnyTimes()
+		mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
+		mockClientController.EXPECT().QueryBestBlock().Return(&types.BlockInfo{Height: currentHeight}, nil).AnyTimes()
+		for i := startHeight; i <= forgedHeight+1; i++ {
+			resBlock := &types.BlockInfo{
+				Height: i,
+				Hash:   testutil.GenRandomByteArray(r, 32),
+			}
+			mockClientController.EXPECT().QueryBlock(i).Return(resBlock, nil).AnyTimes()
+		}
+		verifier := &testHeaderVerifier{forgedHeight: forgedHeight, failed: make(map[uint64]bool)}
+
+		m := metrics.NewFpMetrics()
+		pollerCfg := fpcfg.DefaultChainPollerConfig()
+		pollerCfg.PollInterval = 10 * time.Millisecond
+		poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, mockClientController, verifier, nil, m)
+		err := poller.Start()
+		require.NoError(t, err)
+		sub, err := poller.Subscribe(startHeight)
+		require.NoError(t, err)
+		defer func() {
+			err := poller.Stop()
+			require.NoError(t, err)
+		}()
+
+		for i := startHeight; i < forgedHeight; i++ {
+			select {
+			case info := <-sub.GetBlockInfoChan():
+				require.Equal(t, i, info.Height)
+				require.True(t, verifier.hasFailed(i))
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Failed to get block info")
+			}
+		}
+
+		select {
+		case err := <-sub.GetCriticalErrChan():
+			require.ErrorIs(t, err, service.ErrUnverifiedBlock)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("Failed to get the critical error")
+		}
+
+		// no block is emitted from the forged height
+		select {
+		case info := <-sub.GetBlockInfoChan():
+			t.Fatalf("Unexpected block at height %d", info.Height)
+		default:
+		}
+		require.Equal(t, forgedHeight, sub.NextHeight())
+	})
+}
+
+// TestChainPoller_SubscribeUnreachable tests that subscribing to the poller
+// gives up instead of blocking while the consumer chain is unreachable
+func TestChainPoller_SubscribeUnreachable(t *testing.T) {
+	ctl := gomock.NewController(t)
+	mockClientController := mocks.NewMockClientController(ctl)
+	mockClientController.EXPECT().Close().Return(nil).AnyTimes()
+	mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(0), fmt.Errorf("unreachable")).AnyTimes()
+	mockClientController.EXPECT().QueryBestBlock().Return(nil, fmt.Errorf("unreachable")).AnyTimes()
+
+	pollerCfg := fpcfg.DefaultChainPollerConfig()
+	pollerCfg.PollInterval = 10 * time.Millisecond
+	poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, mockClientController, nil, nil, metrics.NewFpMetrics())
+	err := poller.Start()
+	require.NoError(t, err)
+
+	subErrChan := make(chan error, 1)
+	go func() {
+		_, err := poller.Subscribe(1)
+		subErrChan <- err
+	}()
+
+	// stopping the poller aborts the retries of the subscription
+	time.Sleep(100 * time.Millisecond)
+	err = poller.Stop()
+	require.NoError(t, err)
+
+	select {
+	case err := <-subErrChan:
+		require.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("the subscription is not aborted")
+	}
+}
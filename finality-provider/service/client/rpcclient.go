@@ -87,11 +87,18 @@ func (c *FinalityProviderServiceGRpcClient) CreateFinalityProvider(
 	return res, nil
 }
 
-func (c *FinalityProviderServiceGRpcClient) AddFinalitySignature(ctx context.Context, fpPk string, height uint64, appHash []byte) (*proto.AddFinalitySignatureResponse, error) {
+func (c *FinalityProviderServiceGRpcClient) AddFinalitySignature(
+	ctx context.Context,
+	fpPk string,
+	height uint64,
+	appHash []byte,
+	unsafeSkipConflictCheck bool,
+) (*proto.AddFinalitySignatureResponse, error) {
 	req := &proto.AddFinalitySignatureRequest{
-		BtcPk:   fpPk,
-		Height:  height,
-		AppHash: appHash,
+		BtcPk:                   fpPk,
+		Height:                  height,
+		AppHash:                 appHash,
+		UnsafeSkipConflictCheck: unsafeSkipConflictCheck,
 	}
 
 	res, err := c.client.AddFinalitySignature(ctx, req)
@@ -135,3 +142,25 @@ func (c *FinalityProviderServiceGRpcClient) SignMessageFromChainKey(
 	}
 	return c.client.SignMessageFromChainKey(ctx, req)
 }
+
+// QueryVotes queries the votes recorded in the vote journal of the daemon,
+// where an empty fpPk queries the votes of all the finality providers
+func (c *FinalityProviderServiceGRpcClient) QueryVotes(
+	ctx context.Context,
+	fpPk string,
+	fromHeight, toHeight uint64,
+	limit uint32,
+) (*proto.QueryVotesResponse, error) {
+	req := &proto.QueryVotesRequest{
+		BtcPk:      fpPk,
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+		Limit:      limit,
+	}
+	res, err := c.client.QueryVotes(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
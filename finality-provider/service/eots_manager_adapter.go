@@ -1,9 +1,12 @@
 package service
 
 import (
+	"errors"
 	"fmt"
 
 	bbntypes "github.com/babylonlabs-io/babylon/types"
+	eotstypes "github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/store"
 	"github.com/babylonlabs-io/finality-provider/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
@@ -56,7 +59,27 @@ func getMsgToSignForVote(blockHeight uint64, blockHash []byte) []byte {
 	return append(sdk.Uint64ToBigEndian(blockHeight), blockHash...)
 }
 
+// guardConflictingBlocks persists the hashes of the blocks to sign, and refuses
+// with ErrConflictingBlock if a different block has been signed at any of the
+// heights, e.g., if the consumer chain node serves a fork. This is checked locally
+// before the EOTS manager so that the key is not leaked by a misbehaving node
+func (fp *FinalityProviderInstance) guardConflictingBlocks(blocks []*types.BlockInfo) error {
+	err := fp.fpState.s.SaveSignedBlocks(fp.GetBtcPkBIP340().MustMarshal(), blocks)
+	if errors.Is(err, store.ErrConflictingSignedBlock) {
+		return fmt.Errorf("%w: %w", ErrConflictingBlock, err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save the signed blocks: %w", err)
+	}
+
+	return nil
+}
+
 func (fp *FinalityProviderInstance) signFinalitySig(b *types.BlockInfo) (*bbntypes.SchnorrEOTSSig, error) {
+	if err := fp.guardConflictingBlocks([]*types.BlockInfo{b}); err != nil {
+		return nil, err
+	}
+
 	// build proper finality signature request
 	msgToSign := getMsgToSignForVote(b.Height, b.Hash)
 	sig, err := fp.em.SignEOTS(fp.btcPk.MustMarshal(), fp.GetChainID(), msgToSign, b.Height, fp.passphrase)
@@ -66,3 +89,49 @@ func (fp *FinalityProviderInstance) signFinalitySig(b *types.BlockInfo) (*bbntyp
 
 	return bbntypes.NewSchnorrEOTSSigFromModNScalar(sig), nil
 }
+
+// signFinalitySigs signs the blocks through one request to the EOTS manager
+// so that the key is only loaded once
+func (fp *FinalityProviderInstance) signFinalitySigs(blocks []*types.BlockInfo) ([]*btcec.ModNScalar, error) {
+	if err := fp.guardConflictingBlocks(blocks); err != nil {
+		return nil, err
+	}
+
+	msgs := make([]*eotstypes.HeightMsg, 0, len(blocks))
+	for _, b := range blocks {
+		msgs = append(msgs, &eotstypes.HeightMsg{
+			Height: b.Height,
+			Msg:    getMsgToSignForVote(b.Height, b.Hash),
+		})
+	}
+
+	sigs, err := fp.em.SignEOTSBatch(fp.btcPk.MustMarshal(), fp.GetChainID(), msgs, fp.passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign a batch of EOTS: %w", err)
+	}
+
+	return sigs, nil
+}
+
+// unsafeEOTSSigner is implemented by the EOTS managers which are able to sign
+// without the double-sign protection, i.e., the local EOTS manager and the
+// remote one started with --unsafe-allow-double-sign
+type unsafeEOTSSigner interface {
+	UnsafeSignEOTS(uid []byte, chainID []byte, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error)
+}
+
+// unsafeSignFinalitySig signs the block without the double-sign protection of the EOTS manager
+// NOTE: this should only be used for testing purposes
+func (fp *FinalityProviderInstance) unsafeSignFinalitySig(b *types.BlockInfo) (*bbntypes.SchnorrEOTSSig, error) {
+	msgToSign := getMsgToSignForVote(b.Height, b.Hash)
+	signer, ok := fp.em.(unsafeEOTSSigner)
+	if !ok {
+		return nil, fmt.Errorf("the EOTS manager does not support signing without double-sign protection")
+	}
+	sig, err := signer.UnsafeSignEOTS(fp.btcPk.MustMarshal(), fp.GetChainID(), msgToSign, b.Height, fp.passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign EOTS: %w", err)
+	}
+
+	return bbntypes.NewSchnorrEOTSSigFromModNScalar(sig), nil
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager"
+	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
+)
+
+// inProcessEOTSManager is the EOTS manager running in the same process as the
+// finality provider daemon, which owns its database
+type inProcessEOTSManager struct {
+	*eotsmanager.LocalEOTSManager
+
+	db kvdb.Backend
+}
+
+func newInProcessEOTSManager(cfg *fpcfg.EOTSManagerConfig, logger *zap.Logger) (*inProcessEOTSManager, error) {
+	db, err := cfg.DBConfig().GetDbBackend()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create db backend: %w", err)
+	}
+
+	em, err := eotsmanager.NewLocalEOTSManager(cfg.KeyDirectory, cfg.KeyringBackend, db, logger)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &inProcessEOTSManager{
+		LocalEOTSManager: em,
+		db:               db,
+	}, nil
+}
+
+func (m *inProcessEOTSManager) Close() error {
+	if err := m.LocalEOTSManager.Close(); err != nil {
+		return err
+	}
+
+	return m.db.Close()
+}
@@ -4,4 +4,11 @@ import "errors"
 
 var (
 	ErrFinalityProviderShutDown = errors.New("the finality provider instance is shutting down")
+	// ErrConflictingBlock is critical as signing a conflicting block leaks the key
+	ErrConflictingBlock = errors.New("refused to sign a block conflicting with the one signed at the same height")
+	// ErrUnverifiedBlock is critical as the block may be forged by the rpc server
+	ErrUnverifiedBlock = errors.New("the block cannot be verified by the light client")
+	// ErrPollerUnavailable is reported once the poller fails to retrieve
+	// blocks in consecutive cycles, e.g., during an outage of the rpc server
+	ErrPollerUnavailable = errors.New("the poller failed to retrieve blocks for too many consecutive cycles")
 )
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"github.com/cometbft/cometbft/light/provider"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"go.uber.org/zap"
+
+	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
+)
+
+// NewLightClientVerifierWithProviders creates the light client verifier whose
+// providers are created by the given function instead of connecting to the
+// rpc servers
+func NewLightClientVerifierWithProviders(
+	cfg *fpcfg.LightClientConfig,
+	chainID string,
+	rpcAddr string,
+	db kvdb.Backend,
+	newProvider func(chainID, addr string) (provider.Provider, error),
+	logger *zap.Logger,
+) (*LightClientVerifier, error) {
+	return newLightClientVerifier(cfg, chainID, rpcAddr, db, newProvider, logger)
+}
@@ -53,6 +53,12 @@ func (fp *FinalityProviderInstance) FastSync(startHeight, endHeight uint64) (*Fa
 			if fp.hasProcessed(b) {
 				continue
 			}
+			// the block is never voted for unless it is verified
+			if fp.verifier != nil {
+				if err := fp.verifier.VerifyBlock(b); err != nil {
+					return nil, err
+				}
+			}
 			// check whether the finality provider has voting power
 			hasVp, err := fp.hasVotingPower(b)
 			if err != nil {
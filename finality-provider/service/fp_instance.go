@@ -32,11 +32,18 @@ type FinalityProviderInstance struct {
 	pubRandState *pubRandState
 	cfg          *fpcfg.Config
 
-	logger  *zap.Logger
-	em      eotsmanager.EOTSManager
-	cc      clientcontroller.ClientController
-	poller  *ChainPoller
-	metrics *metrics.FpMetrics
+	logger   *zap.Logger
+	em       eotsmanager.EOTSManager
+	cc       clientcontroller.ClientController
+	verifier HeaderVerifier
+	metrics  *metrics.FpMetrics
+
+	// poller is shared by all the finality-provider instances
+	poller *ChainPoller
+	// pollerSub is replaced each time the instance is started, and mu
+	// guards it against the readers outside the instance, e.g., health checks
+	pollerSub *PollerSubscription
+	mu        sync.Mutex
 
 	// passphrase is used to unlock private keys
 	passphrase string
@@ -48,6 +55,10 @@ type FinalityProviderInstance struct {
 	inSync    *atomic.Bool
 	isLagging *atomic.Bool
 
+	// lifecycleMu serializes starting and stopping the instance, e.g., by
+	// the supervisor restarting it and the manager removing it
+	lifecycleMu sync.Mutex
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -60,6 +71,8 @@ func NewFinalityProviderInstance(
 	s *store.FinalityProviderStore,
 	prStore *store.PubRandProofStore,
 	cc clientcontroller.ClientController,
+	verifier HeaderVerifier,
+	poller *ChainPoller,
 	em eotsmanager.EOTSManager,
 	metrics *metrics.FpMetrics,
 	passphrase string,
@@ -89,34 +102,45 @@ func NewFinalityProviderInstance(
 		passphrase:      passphrase,
 		em:              em,
 		cc:              cc,
+		verifier:        verifier,
+		poller:          poller,
 		metrics:         metrics,
 	}, nil
 }
 
 func (fp *FinalityProviderInstance) Start() error {
+	fp.lifecycleMu.Lock()
+	defer fp.lifecycleMu.Unlock()
+
 	if fp.isStarted.Swap(true) {
 		return fmt.Errorf("the finality-provider instance %s is already started", fp.GetBtcPkHex())
 	}
 
 	fp.logger.Info("Starting finality-provider instance", zap.String("pk", fp.GetBtcPkHex()))
 
+	// the instance can be started again after a failed start
 	startHeight, err := fp.bootstrap()
 	if err != nil {
+		fp.isStarted.Store(false)
 		return fmt.Errorf("failed to bootstrap the finality-provider %s: %w", fp.GetBtcPkHex(), err)
 	}
 
 	fp.logger.Info("the finality-provider has been bootstrapped",
 		zap.String("pk", fp.GetBtcPkHex()), zap.Uint64("height", startHeight))
 
-	poller := NewChainPoller(fp.logger, fp.cfg.PollerConfig, fp.cc, fp.metrics)
-
-	if err := poller.Start(startHeight + 1); err != nil {
-		return fmt.Errorf("failed to start the poller: %w", err)
+	pollerSub, err := fp.poller.Subscribe(startHeight + 1)
+	if err != nil {
+		fp.isStarted.Store(false)
+		return fmt.Errorf("failed to subscribe to the poller: %w", err)
 	}
 
-	fp.poller = poller
+	fp.mu.Lock()
+	fp.pollerSub = pollerSub
+	fp.mu.Unlock()
 
 	fp.laggingTargetChan = make(chan *types.BlockInfo, 1)
+	// the lagging target of the previous run, if any, is dropped
+	fp.isLagging.Store(false)
 
 	fp.quit = make(chan struct{})
 
@@ -152,12 +176,33 @@ func (fp *FinalityProviderInstance) bootstrap() (uint64, error) {
 }
 
 func (fp *FinalityProviderInstance) Stop() error {
+	fp.lifecycleMu.Lock()
+	defer fp.lifecycleMu.Unlock()
+
+	return fp.stop()
+}
+
+// stopIfRunning stops the instance unless it is not running, which may
+// happen if it is stopped concurrently, e.g., while being restarted
+func (fp *FinalityProviderInstance) stopIfRunning() error {
+	fp.lifecycleMu.Lock()
+	defer fp.lifecycleMu.Unlock()
+
+	if !fp.IsRunning() {
+		return nil
+	}
+
+	return fp.stop()
+}
+
+// stop stops the instance, for which the caller should hold the lifecycle lock
+func (fp *FinalityProviderInstance) stop() error {
 	if !fp.isStarted.Swap(false) {
 		return fmt.Errorf("the finality-provider %s has already stopped", fp.GetBtcPkHex())
 	}
 
-	if err := fp.poller.Stop(); err != nil {
-		return fmt.Errorf("failed to stop the poller: %w", err)
+	if err := fp.pollerSub.Close(); err != nil {
+		return fmt.Errorf("failed to unsubscribe from the poller: %w", err)
 	}
 
 	fp.logger.Info("stopping finality-provider instance", zap.String("pk", fp.GetBtcPkHex()))
@@ -165,6 +210,11 @@ func (fp *FinalityProviderInstance) Stop() error {
 	close(fp.quit)
 	fp.wg.Wait()
 
+	// the loops reading the subscription have exited
+	fp.mu.Lock()
+	fp.pollerSub = nil
+	fp.mu.Unlock()
+
 	fp.logger.Info("the finality-provider instance %s is successfully stopped", zap.String("pk", fp.GetBtcPkHex()))
 
 	return nil
@@ -174,12 +224,21 @@ func (fp *FinalityProviderInstance) IsRunning() bool {
 	return fp.isStarted.Load()
 }
 
+// IsPolling returns whether the instance is running and subscribed to the
+// chain poller which is running
+func (fp *FinalityProviderInstance) IsPolling() bool {
+	fp.mu.Lock()
+	defer fp.mu.Unlock()
+
+	return fp.IsRunning() && fp.pollerSub != nil && fp.poller.IsRunning()
+}
+
 func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 	defer fp.wg.Done()
 
 	for {
 		select {
-		case b := <-fp.poller.GetBlockInfoChan():
+		case b := <-fp.pollerSub.GetBlockInfoChan():
 			fp.logger.Debug(
 				"the finality-provider received a new block, start processing",
 				zap.String("pk", fp.GetBtcPkHex()),
@@ -193,7 +252,7 @@ func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 			// check whether the finality provider has voting power
 			hasVp, err := fp.hasVotingPower(b)
 			if err != nil {
-				fp.reportCriticalErr(err)
+				fp.reportCriticalErr(critErrSourceVoting, err)
 				continue
 			}
 			if !hasVp {
@@ -209,7 +268,7 @@ func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 			isFinalized, err := fp.retryCheckRandomnessUntilBlockFinalized(b)
 			if err != nil {
 				if !errors.Is(err, ErrFinalityProviderShutDown) {
-					fp.reportCriticalErr(err)
+					fp.reportCriticalErr(critErrSourceVoting, err)
 				}
 				break
 			}
@@ -225,7 +284,7 @@ func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 			if err != nil {
 				fp.metrics.IncrementFpTotalFailedVotes(fp.GetBtcPkHex())
 				if !errors.Is(err, ErrFinalityProviderShutDown) {
-					fp.reportCriticalErr(err)
+					fp.reportCriticalErr(critErrSourceVoting, err)
 				}
 				continue
 			}
@@ -246,8 +305,11 @@ func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 			res, err := fp.tryFastSync(targetBlock)
 			fp.isLagging.Store(false)
 			if err != nil {
-				if errors.Is(err, bstypes.ErrFpAlreadySlashed) {
-					fp.reportCriticalErr(err)
+				if errors.Is(err, bstypes.ErrFpAlreadySlashed) ||
+					errors.Is(err, ErrConflictingBlock) ||
+					errors.Is(err, ErrUnverifiedBlock) ||
+					errors.Is(err, clientcontroller.ErrBlockHashMismatch) {
+					fp.reportCriticalErr(critErrSourceFastSync, err)
 					continue
 				}
 				fp.logger.Debug(
@@ -268,7 +330,7 @@ func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 
 				// inform the poller to skip to the next block of the last
 				// processed one
-				err := fp.poller.SkipToHeight(fp.GetLastProcessedHeight() + 1)
+				err := fp.pollerSub.SkipToHeight(fp.GetLastProcessedHeight() + 1)
 				if err != nil {
 					fp.logger.Debug(
 						"failed to skip heights from the poller",
@@ -276,6 +338,10 @@ func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 					)
 				}
 			}
+		case err := <-fp.pollerSub.GetCriticalErrChan():
+			// the subscription is halted or the poller is unavailable so no more
+			// blocks are voted for
+			fp.reportCriticalErr(critErrSourcePoller, err)
 		case <-fp.quit:
 			fp.logger.Info("the finality signature submission loop is closing")
 			return
@@ -294,13 +360,13 @@ func (fp *FinalityProviderInstance) randomnessCommitmentLoop() {
 		case <-commitRandTicker.C:
 			tipBlock, err := fp.getLatestBlockWithRetry()
 			if err != nil {
-				fp.reportCriticalErr(err)
+				fp.reportCriticalErr(critErrSourceRandomness, err)
 				continue
 			}
 			txRes, err := fp.retryCommitPubRandUntilBlockFinalized(tipBlock)
 			if err != nil {
 				fp.metrics.IncrementFpTotalFailedRandomness(fp.GetBtcPkHex())
-				fp.reportCriticalErr(err)
+				fp.reportCriticalErr(critErrSourceRandomness, err)
 				continue
 			}
 			// txRes could be nil if no need to commit more randomness
@@ -449,10 +515,16 @@ func (fp *FinalityProviderInstance) hasRandomness(b *types.BlockInfo) (bool, err
 	return true, nil
 }
 
-func (fp *FinalityProviderInstance) reportCriticalErr(err error) {
-	fp.criticalErrChan <- &CriticalError{
+// reportCriticalErr reports the critical error to the finality-provider
+// manager, which is given up once the instance is stopping
+func (fp *FinalityProviderInstance) reportCriticalErr(source string, err error) {
+	select {
+	case fp.criticalErrChan <- &CriticalError{
 		err:     err,
 		fpBtcPk: fp.GetBtcPkBIP340(),
+		source:  source,
+	}:
+	case <-fp.quit:
 	}
 }
 
@@ -552,7 +624,7 @@ func (fp *FinalityProviderInstance) retrySubmitFinalitySignatureUntilBlockFinali
 				zap.Error(err),
 			)
 
-			if clientcontroller.IsUnrecoverable(err) {
+			if clientcontroller.IsUnrecoverable(err) || errors.Is(err, ErrConflictingBlock) {
 				return nil, err
 			}
 
@@ -761,6 +833,7 @@ func (fp *FinalityProviderInstance) SubmitFinalitySignature(b *types.BlockInfo)
 
 	// update DB
 	fp.MustUpdateStateAfterFinalitySigSubmission(b.Height)
+	fp.recordVotes([]*types.BlockInfo{b}, []*btcec.FieldVal{pubRand}, res, false)
 
 	// update metrics
 	fp.metrics.RecordFpVoteTime(fp.GetBtcPkHex())
@@ -789,13 +862,9 @@ func (fp *FinalityProviderInstance) SubmitBatchFinalitySignatures(blocks []*type
 	}
 
 	// sign blocks
-	sigList := make([]*btcec.ModNScalar, 0, len(blocks))
-	for _, b := range blocks {
-		eotsSig, err := fp.signFinalitySig(b)
-		if err != nil {
-			return nil, err
-		}
-		sigList = append(sigList, eotsSig.ToModNScalar())
+	sigList, err := fp.signFinalitySigs(blocks)
+	if err != nil {
+		return nil, err
 	}
 
 	// send finality signature to the consumer chain
@@ -807,6 +876,7 @@ func (fp *FinalityProviderInstance) SubmitBatchFinalitySignatures(blocks []*type
 	// update DB
 	highBlock := blocks[len(blocks)-1]
 	fp.MustUpdateStateAfterFinalitySigSubmission(highBlock.Height)
+	fp.recordVotes(blocks, prList, res, true)
 
 	return res, nil
 }
@@ -814,7 +884,12 @@ func (fp *FinalityProviderInstance) SubmitBatchFinalitySignatures(blocks []*type
 // TestSubmitFinalitySignatureAndExtractPrivKey is exposed for presentation/testing purpose to allow manual sending finality signature
 // this API is the same as SubmitFinalitySignature except that we don't constraint the voting height and update status
 // Note: this should not be used in the submission loop
-func (fp *FinalityProviderInstance) TestSubmitFinalitySignatureAndExtractPrivKey(b *types.BlockInfo) (*types.TxResponse, *btcec.PrivateKey, error) {
+// The block is refused if it conflicts with the block signed at the same height,
+// unless unsafeSkipConflictCheck is set to deliberately equivocate
+func (fp *FinalityProviderInstance) TestSubmitFinalitySignatureAndExtractPrivKey(
+	b *types.BlockInfo,
+	unsafeSkipConflictCheck bool,
+) (*types.TxResponse, *btcec.PrivateKey, error) {
 	// check last committed height
 	lastCommittedHeight, err := fp.GetLastCommittedHeight()
 	if err != nil {
@@ -838,8 +913,14 @@ func (fp *FinalityProviderInstance) TestSubmitFinalitySignatureAndExtractPrivKey
 		return nil, nil, fmt.Errorf("failed to get public randomness inclusion proof: %v", err)
 	}
 
-	// sign block
-	eotsSig, err := fp.signFinalitySig(b)
+	var eotsSig *bbntypes.SchnorrEOTSSig
+	if unsafeSkipConflictCheck {
+		// sign block without double-sign protection of the EOTS manager as it
+		// is meant to demonstrate the extraction of the private key upon equivocation
+		eotsSig, err = fp.unsafeSignFinalitySig(b)
+	} else {
+		eotsSig, err = fp.signFinalitySig(b)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -850,6 +931,8 @@ func (fp *FinalityProviderInstance) TestSubmitFinalitySignatureAndExtractPrivKey
 		return nil, nil, fmt.Errorf("failed to send finality signature to the consumer chain: %w", err)
 	}
 
+	fp.recordVotes([]*types.BlockInfo{b}, []*btcec.FieldVal{pubRand}, res, false)
+
 	// try to extract the private key
 	var privKey *btcec.PrivateKey
 	for _, ev := range res.Events {
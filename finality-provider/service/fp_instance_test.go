@@ -1,6 +1,7 @@
 package service_test
 
 import (
+	"encoding/hex"
 	"math/rand"
 	"os"
 	"path/filepath"
@@ -18,6 +19,7 @@ import (
 	"github.com/babylonlabs-io/finality-provider/finality-provider/config"
 	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
 	"github.com/babylonlabs-io/finality-provider/finality-provider/service"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/store"
 	"github.com/babylonlabs-io/finality-provider/metrics"
 	"github.com/babylonlabs-io/finality-provider/testutil"
 	"github.com/babylonlabs-io/finality-provider/types"
@@ -58,7 +60,7 @@ func FuzzSubmitFinalitySig(f *testing.F) {
 		startingBlock := &types.BlockInfo{Height: randomStartingHeight, Hash: testutil.GenRandomByteArray(r, 32)}
 		mockClientController := testutil.PrepareMockedClientController(t, r, randomStartingHeight, currentHeight)
 		mockClientController.EXPECT().QueryLatestFinalizedBlocks(gomock.Any()).Return(nil, nil).AnyTimes()
-		_, fpIns, cleanUp := startFinalityProviderAppWithRegisteredFp(t, r, mockClientController, randomStartingHeight)
+		app, fpIns, cleanUp := startFinalityProviderAppWithRegisteredFp(t, r, mockClientController, randomStartingHeight)
 		defer cleanUp()
 
 		// commit pub rand
@@ -95,6 +97,41 @@ func FuzzSubmitFinalitySig(f *testing.F) {
 		// check the last_voted_height
 		require.Equal(t, nextBlock.Height, fpIns.GetLastVotedHeight())
 		require.Equal(t, nextBlock.Height, fpIns.GetLastProcessedHeight())
+
+		// check the vote is recorded in the vote journal
+		votes, err := app.QueryVotes(&store.VoteFilter{BtcPk: fpIns.GetBtcPk()})
+		require.NoError(t, err)
+		require.Len(t, votes, 1)
+		require.Equal(t, nextBlock.Height, votes[0].Height)
+		require.Equal(t, hex.EncodeToString(nextBlock.Hash), votes[0].BlockHashHex)
+		require.Equal(t, expectedTxHash, votes[0].TxHash)
+		require.False(t, votes[0].Batched)
+
+		// a conflicting block at the signed height is refused
+		conflictingBlock := &types.BlockInfo{
+			Height: nextBlock.Height,
+			Hash:   testutil.GenRandomByteArray(r, 32),
+		}
+		_, err = fpIns.SubmitFinalitySignature(conflictingBlock)
+		require.ErrorIs(t, err, service.ErrConflictingBlock)
+		_, _, err = fpIns.TestSubmitFinalitySignatureAndExtractPrivKey(conflictingBlock, false)
+		require.ErrorIs(t, err, service.ErrConflictingBlock)
+		_, err = fpIns.SubmitBatchFinalitySignatures([]*types.BlockInfo{conflictingBlock})
+		require.ErrorIs(t, err, service.ErrConflictingBlock)
+
+		// a deliberate vote for the conflicting block is recorded besides
+		// the vote for the block signed before
+		mockClientController.EXPECT().
+			SubmitFinalitySig(fpIns.GetBtcPk(), conflictingBlock, gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&types.TxResponse{TxHash: testutil.GenRandomHexStr(r, 32)}, nil).Times(1)
+		_, _, err = fpIns.TestSubmitFinalitySignatureAndExtractPrivKey(conflictingBlock, true)
+		require.NoError(t, err)
+		votes, err = app.QueryVotes(&store.VoteFilter{BtcPk: fpIns.GetBtcPk()})
+		require.NoError(t, err)
+		require.Len(t, votes, 2)
+		blockHashes := []string{votes[0].BlockHashHex, votes[1].BlockHashHex}
+		require.Contains(t, blockHashes, hex.EncodeToString(nextBlock.Hash))
+		require.Contains(t, blockHashes, hex.EncodeToString(conflictingBlock.Hash))
 	})
 }
 
@@ -131,7 +168,7 @@ func startFinalityProviderAppWithRegisteredFp(t *testing.T, r *rand.Rand, cc cli
 	require.NoError(t, err)
 	// TODO: use mock metrics
 	m := metrics.NewFpMetrics()
-	fpIns, err := service.NewFinalityProviderInstance(fp.GetBIP340BTCPK(), &fpCfg, fpStore, pubRandProofStore, cc, em, m, passphrase, make(chan *service.CriticalError), logger)
+	fpIns, err := service.NewFinalityProviderInstance(fp.GetBIP340BTCPK(), &fpCfg, fpStore, pubRandProofStore, cc, nil, nil, em, m, passphrase, make(chan *service.CriticalError), logger)
 	require.NoError(t, err)
 
 	cleanUp := func() {
@@ -26,6 +26,8 @@ const instanceTerminatingMsg = "terminating the finality-provider instance due t
 type CriticalError struct {
 	err     error
 	fpBtcPk *bbntypes.BIP340PubKey
+	// source is where the error occurred in the finality-provider instance
+	source string
 }
 
 func (ce *CriticalError) Error() string {
@@ -34,18 +36,27 @@ func (ce *CriticalError) Error() string {
 
 type FinalityProviderManager struct {
 	isStarted *atomic.Bool
+	// lifecycleMu serializes starting and stopping the manager
+	lifecycleMu sync.Mutex
 
 	mu sync.Mutex
 	wg sync.WaitGroup
 
 	// running finality-provider instances map keyed by the hex string of the BTC public key
 	fpis map[string]*FinalityProviderInstance
+	// starting reserves the keys of the instances being started, which are
+	// added into fpis once started
+	starting map[string]struct{}
+	// restarts of the finality-provider instances after critical errors
+	restarts map[string]*restartState
 
 	// needed for initiating finality-provider instances
 	fps          *store.FinalityProviderStore
 	pubRandStore *store.PubRandProofStore
 	config       *fpcfg.Config
 	cc           clientcontroller.ClientController
+	verifier     HeaderVerifier
+	subscriber   clientcontroller.BlockSubscriber
 	em           eotsmanager.EOTSManager
 	logger       *zap.Logger
 
@@ -53,7 +64,10 @@ type FinalityProviderManager struct {
 
 	criticalErrChan chan *CriticalError
 
-	quit chan struct{}
+	// poller and quit are replaced each time the manager is started, as they
+	// cannot be reused once stopped, and mu guards the poller
+	poller *ChainPoller
+	quit   chan struct{}
 }
 
 func NewFinalityProviderManager(
@@ -61,29 +75,43 @@ func NewFinalityProviderManager(
 	pubRandStore *store.PubRandProofStore,
 	config *fpcfg.Config,
 	cc clientcontroller.ClientController,
+	verifier HeaderVerifier,
 	em eotsmanager.EOTSManager,
 	metrics *metrics.FpMetrics,
 	logger *zap.Logger,
 ) (*FinalityProviderManager, error) {
+	var subscriber clientcontroller.BlockSubscriber
+	if config.PollerConfig.NewBlockSubscription {
+		s, err := clientcontroller.NewCometBFTBlockSubscriber(config.BabylonConfig.RPCAddr, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the new block subscriber: %w", err)
+		}
+		subscriber = s
+	}
+
 	return &FinalityProviderManager{
 		fpis:            make(map[string]*FinalityProviderInstance),
+		starting:        make(map[string]struct{}),
+		restarts:        make(map[string]*restartState),
 		criticalErrChan: make(chan *CriticalError),
 		isStarted:       atomic.NewBool(false),
 		fps:             fps,
 		pubRandStore:    pubRandStore,
 		config:          config,
 		cc:              cc,
+		verifier:        verifier,
+		subscriber:      subscriber,
 		em:              em,
 		metrics:         metrics,
 		logger:          logger,
-		quit:            make(chan struct{}),
 	}, nil
 }
 
 // monitorCriticalErr takes actions when it receives critical errors from a finality-provider instance
 // if the finality-provider is slashed, it will be terminated and the program keeps running in case
 // new finality providers join
-// otherwise, the program will panic
+// otherwise, the instance is restarted with backoff, or the error is escalated according to the
+// exit policy if it cannot be resolved by restarts
 func (fpm *FinalityProviderManager) monitorCriticalErr() {
 	defer fpm.wg.Done()
 
@@ -105,8 +133,7 @@ func (fpm *FinalityProviderManager) monitorCriticalErr() {
 					zap.String("pk", criticalErr.fpBtcPk.MarshalHex()))
 				continue
 			}
-			fpm.logger.Fatal(instanceTerminatingMsg,
-				zap.String("pk", criticalErr.fpBtcPk.MarshalHex()), zap.Error(criticalErr.err))
+			fpm.superviseCriticalErr(fpi, criticalErr)
 		case <-fpm.quit:
 			return
 		}
@@ -207,15 +234,40 @@ func (fpm *FinalityProviderManager) setFinalityProviderSlashed(fpi *FinalityProv
 	}
 }
 
-func (fpm *FinalityProviderManager) StartFinalityProvider(fpPk *bbntypes.BIP340PubKey, passphrase string) error {
-	if !fpm.isStarted.Load() {
-		fpm.isStarted.Store(true)
+// start starts the poller and the monitoring routines unless the manager is
+// already started. The blocks are polled once for all the finality-provider
+// instances by a new poller each time the manager is started
+func (fpm *FinalityProviderManager) start() error {
+	fpm.lifecycleMu.Lock()
+	defer fpm.lifecycleMu.Unlock()
 
-		fpm.wg.Add(1)
-		go fpm.monitorCriticalErr()
+	if fpm.isStarted.Load() {
+		return nil
+	}
 
-		fpm.wg.Add(1)
-		go fpm.monitorStatusUpdate()
+	poller := NewChainPoller(fpm.logger, fpm.config.PollerConfig, fpm.cc, fpm.verifier, fpm.subscriber, fpm.metrics)
+	if err := poller.Start(); err != nil {
+		return fmt.Errorf("failed to start the poller: %w", err)
+	}
+
+	fpm.mu.Lock()
+	fpm.poller = poller
+	fpm.mu.Unlock()
+	fpm.quit = make(chan struct{})
+	fpm.isStarted.Store(true)
+
+	fpm.wg.Add(1)
+	go fpm.monitorCriticalErr()
+
+	fpm.wg.Add(1)
+	go fpm.monitorStatusUpdate()
+
+	return nil
+}
+
+func (fpm *FinalityProviderManager) StartFinalityProvider(fpPk *bbntypes.BIP340PubKey, passphrase string) error {
+	if err := fpm.start(); err != nil {
+		return err
 	}
 
 	if fpm.numOfRunningFinalityProviders() >= int(fpm.config.MaxNumFinalityProviders) {
@@ -230,14 +282,8 @@ func (fpm *FinalityProviderManager) StartFinalityProvider(fpPk *bbntypes.BIP340P
 }
 
 func (fpm *FinalityProviderManager) StartAll() error {
-	if !fpm.isStarted.Load() {
-		fpm.isStarted.Store(true)
-
-		fpm.wg.Add(1)
-		go fpm.monitorCriticalErr()
-
-		fpm.wg.Add(1)
-		go fpm.monitorStatusUpdate()
+	if err := fpm.start(); err != nil {
+		return err
 	}
 
 	storedFps, err := fpm.fps.GetAllStoredFinalityProviders()
@@ -260,26 +306,38 @@ func (fpm *FinalityProviderManager) StartAll() error {
 	return nil
 }
 
+// Stop stops and removes all the finality-provider instances, which can be
+// started again once the manager is started again
 func (fpm *FinalityProviderManager) Stop() error {
+	fpm.lifecycleMu.Lock()
+	defer fpm.lifecycleMu.Unlock()
+
 	if !fpm.isStarted.Swap(false) {
 		return fmt.Errorf("the finality-provider manager has already stopped")
 	}
 
+	// no instance is restarted once the supervisor is stopped
+	close(fpm.quit)
+	fpm.wg.Wait()
+
 	var stopErr error
 
-	for _, fpi := range fpm.fpis {
-		if !fpi.IsRunning() {
-			continue
-		}
-		if err := fpi.Stop(); err != nil {
+	fpm.mu.Lock()
+	fpis := fpm.fpis
+	fpm.fpis = make(map[string]*FinalityProviderInstance)
+	poller := fpm.poller
+	fpm.mu.Unlock()
+
+	for _, fpi := range fpis {
+		fpm.metrics.DecrementRunningFpGauge()
+		if err := fpi.stopIfRunning(); err != nil && stopErr == nil {
 			stopErr = err
-			break
 		}
-		fpm.metrics.DecrementRunningFpGauge()
 	}
 
-	close(fpm.quit)
-	fpm.wg.Wait()
+	if err := poller.Stop(); err != nil && stopErr == nil {
+		stopErr = err
+	}
 
 	return stopErr
 }
@@ -309,6 +367,7 @@ func (fpm *FinalityProviderManager) AllFinalityProviders() ([]*proto.FinalityPro
 		if fpm.IsFinalityProviderRunning(fp.GetBIP340BTCPK()) {
 			fpInfo.IsRunning = true
 		}
+		fpm.setRestartInfo(fpInfo)
 
 		fpsInfo = append(fpsInfo, fpInfo)
 	}
@@ -327,6 +386,7 @@ func (fpm *FinalityProviderManager) FinalityProviderInfo(fpPk *bbntypes.BIP340Pu
 	if fpm.IsFinalityProviderRunning(fpPk) {
 		fpInfo.IsRunning = true
 	}
+	fpm.setRestartInfo(fpInfo)
 
 	return fpInfo, nil
 }
@@ -352,23 +412,26 @@ func (fpm *FinalityProviderManager) GetFinalityProviderInstance(fpPk *bbntypes.B
 	return v, nil
 }
 
+// removeFinalityProviderInstance removes the instance from the manager and
+// stops it, which is done without holding the lock as stopping may wait for
+// a concurrent restart of the instance
 func (fpm *FinalityProviderManager) removeFinalityProviderInstance(fpPk *bbntypes.BIP340PubKey) error {
-	fpm.mu.Lock()
-	defer fpm.mu.Unlock()
-
 	keyHex := fpPk.MarshalHex()
+
+	fpm.mu.Lock()
 	fpi, exists := fpm.fpis[keyHex]
 	if !exists {
+		fpm.mu.Unlock()
 		return fmt.Errorf("cannot find the finality-provider instance with PK: %s", keyHex)
 	}
-	if fpi.IsRunning() {
-		if err := fpi.Stop(); err != nil {
-			return fmt.Errorf("failed to stop the finality-provider instance %s", keyHex)
-		}
-	}
-
 	delete(fpm.fpis, keyHex)
 	fpm.metrics.DecrementRunningFpGauge()
+	fpm.mu.Unlock()
+
+	if err := fpi.stopIfRunning(); err != nil {
+		return fmt.Errorf("failed to stop the finality-provider instance %s: %w", keyHex, err)
+	}
+
 	return nil
 }
 
@@ -379,34 +442,63 @@ func (fpm *FinalityProviderManager) numOfRunningFinalityProviders() int {
 	return len(fpm.fpis)
 }
 
-// addFinalityProviderInstance creates a finality-provider instance, starts it and adds it into the finality-provider manager
+// addFinalityProviderInstance creates a finality-provider instance, starts it and adds it into the finality-provider manager.
+// The instance is started without holding the lock as it may take a while if the consumer chain is unreachable
 func (fpm *FinalityProviderManager) addFinalityProviderInstance(
 	pk *bbntypes.BIP340PubKey,
 	passphrase string,
 ) error {
-	fpm.mu.Lock()
-	defer fpm.mu.Unlock()
-
 	pkHex := pk.MarshalHex()
-	if _, exists := fpm.fpis[pkHex]; exists {
+
+	fpm.mu.Lock()
+	_, exists := fpm.fpis[pkHex]
+	_, isStarting := fpm.starting[pkHex]
+	if exists || isStarting {
+		fpm.mu.Unlock()
 		return fmt.Errorf("finality-provider instance already exists")
 	}
+	fpm.starting[pkHex] = struct{}{}
+	fpm.mu.Unlock()
 
-	fpIns, err := NewFinalityProviderInstance(pk, fpm.config, fpm.fps, fpm.pubRandStore, fpm.cc, fpm.em, fpm.metrics, passphrase, fpm.criticalErrChan, fpm.logger)
-	if err != nil {
-		return fmt.Errorf("failed to create finality-provider %s instance: %w", pkHex, err)
-	}
+	fpIns, err := fpm.startFinalityProviderInstance(pk, passphrase)
 
-	if err := fpIns.Start(); err != nil {
-		return fmt.Errorf("failed to start finality-provider %s instance: %w", pkHex, err)
+	fpm.mu.Lock()
+	defer fpm.mu.Unlock()
+
+	delete(fpm.starting, pkHex)
+	if err != nil {
+		return err
 	}
 
 	fpm.fpis[pkHex] = fpIns
+	fpm.resetConsecutiveRestarts(pkHex)
 	fpm.metrics.IncrementRunningFpGauge()
 
 	return nil
 }
 
+func (fpm *FinalityProviderManager) startFinalityProviderInstance(
+	pk *bbntypes.BIP340PubKey,
+	passphrase string,
+) (*FinalityProviderInstance, error) {
+	pkHex := pk.MarshalHex()
+
+	fpm.mu.Lock()
+	poller := fpm.poller
+	fpm.mu.Unlock()
+
+	fpIns, err := NewFinalityProviderInstance(pk, fpm.config, fpm.fps, fpm.pubRandStore, fpm.cc, fpm.verifier, poller, fpm.em, fpm.metrics, passphrase, fpm.criticalErrChan, fpm.logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create finality-provider %s instance: %w", pkHex, err)
+	}
+
+	if err := fpIns.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start finality-provider %s instance: %w", pkHex, err)
+	}
+
+	return fpIns, nil
+}
+
 func (fpm *FinalityProviderManager) getLatestBlockWithRetry() (*types.BlockInfo, error) {
 	var (
 		latestBlock *types.BlockInfo
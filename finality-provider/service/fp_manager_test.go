@@ -1,6 +1,8 @@
 package service_test
 
 import (
+	"errors"
+	"fmt"
 	"math/rand"
 	"os"
 	"path/filepath"
@@ -12,6 +14,7 @@ import (
 	bbntypes "github.com/babylonlabs-io/babylon/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/babylonlabs-io/finality-provider/clientcontroller"
@@ -85,6 +88,213 @@ func FuzzStatusUpdate(f *testing.F) {
 	})
 }
 
+// FuzzSupervisor_RestartOnTransientError tests that the finality-provider
+// instance is restarted after failing to commit public randomness
+func FuzzSupervisor_RestartOnTransientError(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		vm, fpPk, cleanUp := newFinalityProviderManagerWithRegisteredFp(t, r, mockClientController, supervisorTestConfig)
+		defer cleanUp()
+
+		currentHeight := uint64(r.Int63n(100) + 1)
+		mockSupervisorTestClient(r, mockClientController, currentHeight)
+
+		// the first commits fail, after which the restarted instance succeeds
+		numFailures := r.Intn(3) + 1
+		failedCommits := mockClientController.EXPECT().
+			CommitPubRandList(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("the rpc server is unavailable")).Times(numFailures)
+		committed := atomic.NewBool(false)
+		mockClientController.EXPECT().
+			CommitPubRandList(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_, _, _, _, _ any) (*types.TxResponse, error) {
+				committed.Store(true)
+				return &types.TxResponse{TxHash: ""}, nil
+			}).AnyTimes().After(failedCommits)
+
+		err := vm.StartFinalityProvider(fpPk, passphrase)
+		require.NoError(t, err)
+
+		var fpInfo *proto.FinalityProviderInfo
+		require.Eventually(t, func() bool {
+			fpInfo, err = vm.FinalityProviderInfo(fpPk)
+			require.NoError(t, err)
+			return committed.Load() && fpInfo.RestartCount > 0
+		}, supervisorWaitTimeOut, eventuallyPollTime)
+		require.True(t, vm.IsFinalityProviderRunning(fpPk))
+
+		// the errors reported while the instance is restarting are ignored
+		require.LessOrEqual(t, fpInfo.RestartCount, uint32(numFailures))
+		require.True(t, strings.HasPrefix(fpInfo.LastRestartReason, "randomness: "))
+	})
+}
+
+// FuzzSupervisor_MaxRestarts tests that the finality-provider instance is
+// stopped once its restarts are exhausted if the daemon never exits
+func FuzzSupervisor_MaxRestarts(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		maxRestarts := uint32(r.Intn(3) + 1)
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		vm, fpPk, cleanUp := newFinalityProviderManagerWithRegisteredFp(t, r, mockClientController,
+			supervisorTestConfig, func(cfg *fpcfg.Config) {
+				cfg.Supervisor.ExitPolicy = fpcfg.ExitPolicyNever
+				cfg.Supervisor.MaxRestarts = maxRestarts
+			})
+		defer cleanUp()
+
+		currentHeight := uint64(r.Int63n(100) + 1)
+		mockSupervisorTestClient(r, mockClientController, currentHeight)
+		mockClientController.EXPECT().
+			CommitPubRandList(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("the rpc server is unavailable")).AnyTimes()
+
+		err := vm.StartFinalityProvider(fpPk, passphrase)
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			return !vm.IsFinalityProviderRunning(fpPk)
+		}, supervisorWaitTimeOut, eventuallyPollTime)
+
+		fpInfo, err := vm.FinalityProviderInfo(fpPk)
+		require.NoError(t, err)
+		require.False(t, fpInfo.IsRunning)
+		require.Equal(t, maxRestarts, fpInfo.RestartCount)
+	})
+}
+
+// FuzzSupervisor_HaltOnBlockHashMismatch tests that the finality-provider
+// instance expecting a block that the rpc servers disagree on is stopped
+// without exiting the daemon under the default exit policy
+func FuzzSupervisor_HaltOnBlockHashMismatch(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		vm, fpPk, cleanUp := newFinalityProviderManagerWithRegisteredFp(t, r, mockClientController, supervisorTestConfig)
+		defer cleanUp()
+
+		currentHeight := uint64(r.Int63n(100) + 1)
+		currentBlockRes := &types.BlockInfo{
+			Height: currentHeight,
+			Hash:   datagen.GenRandomByteArray(r, 32),
+		}
+		mockClientController.EXPECT().QueryBestBlock().Return(currentBlockRes, nil).AnyTimes()
+		mockClientController.EXPECT().Close().Return(nil).AnyTimes()
+		mockClientController.EXPECT().QueryLatestFinalizedBlocks(gomock.Any()).Return(nil, nil).AnyTimes()
+		mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
+		mockClientController.EXPECT().QueryBlock(gomock.Any()).
+			Return(nil, fmt.Errorf("%w: the rpc servers disagree", clientcontroller.ErrBlockHashMismatch)).AnyTimes()
+		mockClientController.EXPECT().QueryLastCommittedPublicRand(gomock.Any(), uint64(1)).Return(nil, nil).AnyTimes()
+		mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), gomock.Any()).Return(uint64(0), nil).AnyTimes()
+		mockClientController.EXPECT().
+			CommitPubRandList(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&types.TxResponse{TxHash: ""}, nil).AnyTimes()
+
+		err := vm.StartFinalityProvider(fpPk, passphrase)
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			return !vm.IsFinalityProviderRunning(fpPk)
+		}, supervisorWaitTimeOut, eventuallyPollTime)
+
+		// the instance is stopped without being restarted
+		fpInfo, err := vm.FinalityProviderInfo(fpPk)
+		require.NoError(t, err)
+		require.False(t, fpInfo.IsRunning)
+		require.Zero(t, fpInfo.RestartCount)
+	})
+}
+
+// FuzzStartStopManager tests that the manager is started once by concurrent
+// requests, and the finality-provider instances poll the blocks again once
+// the manager is stopped and started again
+func FuzzStartStopManager(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		vm, fpPk, cleanUp := newFinalityProviderManagerWithRegisteredFp(t, r, mockClientController, supervisorTestConfig)
+		defer cleanUp()
+
+		currentHeight := uint64(r.Int63n(100) + 1)
+		mockSupervisorTestClient(r, mockClientController, currentHeight)
+		mockClientController.EXPECT().
+			CommitPubRandList(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&types.TxResponse{TxHash: ""}, nil).AnyTimes()
+
+		// only one of the concurrent requests starts the instance, while
+		// none of them fails to start the manager
+		numStarts := r.Intn(5) + 2
+		errs := make(chan error, numStarts)
+		for i := 0; i < numStarts; i++ {
+			go func() {
+				errs <- vm.StartAll()
+			}()
+		}
+		numStarted := 0
+		for i := 0; i < numStarts; i++ {
+			if err := <-errs; err == nil {
+				numStarted++
+			} else {
+				require.ErrorContains(t, err, "already exists")
+			}
+		}
+		require.Equal(t, 1, numStarted)
+		require.Len(t, vm.ListFinalityProviderInstances(), 1)
+
+		for i := 0; i < 2; i++ {
+			require.Eventually(t, func() bool {
+				return vm.ListFinalityProviderInstances()[0].IsPolling()
+			}, eventuallyWaitTimeOut, eventuallyPollTime)
+
+			err := vm.Stop()
+			require.NoError(t, err)
+			require.False(t, vm.IsFinalityProviderRunning(fpPk))
+
+			err = vm.StartFinalityProvider(fpPk, passphrase)
+			require.NoError(t, err)
+		}
+	})
+}
+
+var supervisorWaitTimeOut = 10 * time.Second
+
+// supervisorTestConfig commits public randomness frequently without retries
+// so that the failures are reported as critical errors
+func supervisorTestConfig(cfg *fpcfg.Config) {
+	cfg.StatusUpdateInterval = 0
+	cfg.RandomnessCommitInterval = 10 * time.Millisecond
+	cfg.MaxSubmissionRetries = 0
+	cfg.Supervisor.RestartBackoff = 10 * time.Millisecond
+	cfg.Supervisor.MaxRestartBackoff = 50 * time.Millisecond
+}
+
+func mockSupervisorTestClient(r *rand.Rand, mockClientController *mocks.MockClientController, currentHeight uint64) {
+	currentBlockRes := &types.BlockInfo{
+		Height: currentHeight,
+		Hash:   datagen.GenRandomByteArray(r, 32),
+	}
+	mockClientController.EXPECT().QueryBestBlock().Return(currentBlockRes, nil).AnyTimes()
+	mockClientController.EXPECT().Close().Return(nil).AnyTimes()
+	mockClientController.EXPECT().QueryLatestFinalizedBlocks(gomock.Any()).Return(nil, nil).AnyTimes()
+	mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
+	mockClientController.EXPECT().QueryBlock(gomock.Any()).Return(currentBlockRes, nil).AnyTimes()
+	mockClientController.EXPECT().QueryLastCommittedPublicRand(gomock.Any(), uint64(1)).Return(nil, nil).AnyTimes()
+	mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), gomock.Any()).Return(uint64(0), nil).AnyTimes()
+}
+
 func waitForStatus(t *testing.T, fpIns *service.FinalityProviderInstance, s proto.FinalityProviderStatus) {
 	require.Eventually(t,
 		func() bool {
@@ -92,7 +302,7 @@ func waitForStatus(t *testing.T, fpIns *service.FinalityProviderInstance, s prot
 		}, eventuallyWaitTimeOut, eventuallyPollTime)
 }
 
-func newFinalityProviderManagerWithRegisteredFp(t *testing.T, r *rand.Rand, cc clientcontroller.ClientController) (*service.FinalityProviderManager, *bbntypes.BIP340PubKey, func()) {
+func newFinalityProviderManagerWithRegisteredFp(t *testing.T, r *rand.Rand, cc clientcontroller.ClientController, cfgOpts ...func(cfg *fpcfg.Config)) (*service.FinalityProviderManager, *bbntypes.BIP340PubKey, func()) {
 	logger := zap.NewNop()
 	// create an EOTS manager
 	eotsHomeDir := filepath.Join(t.TempDir(), "eots-home")
@@ -106,6 +316,9 @@ func newFinalityProviderManagerWithRegisteredFp(t *testing.T, r *rand.Rand, cc c
 	fpHomeDir := filepath.Join(t.TempDir(), "fp-home")
 	fpCfg := fpcfg.DefaultConfigWithHome(fpHomeDir)
 	fpCfg.StatusUpdateInterval = 10 * time.Millisecond
+	for _, opt := range cfgOpts {
+		opt(&fpCfg)
+	}
 	input := strings.NewReader("")
 	kr, err := keyring.CreateKeyring(
 		fpCfg.BabylonConfig.KeyDirectory,
@@ -124,7 +337,7 @@ func newFinalityProviderManagerWithRegisteredFp(t *testing.T, r *rand.Rand, cc c
 	require.NoError(t, err)
 
 	metricsCollectors := metrics.NewFpMetrics()
-	vm, err := service.NewFinalityProviderManager(fpStore, pubRandStore, &fpCfg, cc, em, metricsCollectors, logger)
+	vm, err := service.NewFinalityProviderManager(fpStore, pubRandStore, &fpCfg, cc, nil, em, metricsCollectors, logger)
 	require.NoError(t, err)
 
 	// create registered finality-provider
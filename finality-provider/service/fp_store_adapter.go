@@ -2,6 +2,7 @@ package service
 
 import (
 	"sync"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	bbntypes "github.com/babylonlabs-io/babylon/types"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
 	"github.com/babylonlabs-io/finality-provider/finality-provider/store"
+	"github.com/babylonlabs-io/finality-provider/types"
 )
 
 type createFinalityProviderResponse struct {
@@ -168,3 +170,39 @@ func (fp *FinalityProviderInstance) MustUpdateStateAfterFinalitySigSubmission(he
 	fp.metrics.RecordFpLastVotedHeight(fp.GetBtcPkHex(), height)
 	fp.metrics.RecordFpLastProcessedHeight(fp.GetBtcPkHex(), height)
 }
+
+// recordVotes records the submitted votes in the vote journal. As the journal
+// is only used for auditing, a failure to record is logged without failing the
+// submission
+func (fp *FinalityProviderInstance) recordVotes(
+	blocks []*types.BlockInfo,
+	pubRandList []*btcec.FieldVal,
+	res *types.TxResponse,
+	batched bool,
+) {
+	var txHash string
+	if res != nil {
+		txHash = res.TxHash
+	}
+
+	now := time.Now().UnixNano()
+	pkBytes := fp.GetBtcPkBIP340().MustMarshal()
+	votes := make([]*proto.VoteRecord, 0, len(blocks))
+	for i, b := range blocks {
+		pubRandBytes := *pubRandList[i].Bytes()
+		votes = append(votes, &proto.VoteRecord{
+			BtcPk:     pkBytes,
+			Height:    b.Height,
+			BlockHash: b.Hash,
+			PubRand:   pubRandBytes[:],
+			TxHash:    txHash,
+			Timestamp: now,
+			Batched:   batched,
+		})
+	}
+
+	if err := fp.fpState.s.AddVotes(votes); err != nil {
+		fp.logger.Error("failed to record the votes in the vote journal",
+			zap.String("pk", fp.GetBtcPkHex()), zap.Int("num_votes", len(votes)), zap.Error(err))
+	}
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/babylonlabs-io/finality-provider/clientcontroller"
+	"github.com/babylonlabs-io/finality-provider/metrics"
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+// ReadinessChecks returns the checks of whether the finality-provider daemon is
+// ready, i.e., the EOTS manager and the consumer chain are reachable, and every
+// running finality-provider instance is polling the consumer chain without lagging.
+// The checks share a single query of the best block, so they should be created
+// for each probe
+func (app *FinalityProviderApp) ReadinessChecks() []metrics.ReadinessCheck {
+	bestBlock := newBestBlockQuery(app.cc)
+
+	return []metrics.ReadinessCheck{
+		{Name: "eots_manager", Check: app.checkEOTSManager},
+		{Name: "consumer_chain", Check: func(ctx context.Context) error {
+			return app.checkConsumerChain(ctx, bestBlock)
+		}},
+		{Name: "finality_providers", Check: func(ctx context.Context) error {
+			return app.checkFinalityProviders(ctx, bestBlock)
+		}},
+	}
+}
+
+// bestBlockQuery queries the best block of the consumer chain at most once,
+// and its callers stop waiting for the result once their context is done
+type bestBlockQuery struct {
+	cc   clientcontroller.ClientController
+	once sync.Once
+	done chan struct{}
+
+	block *types.BlockInfo
+	err   error
+}
+
+func newBestBlockQuery(cc clientcontroller.ClientController) *bestBlockQuery {
+	return &bestBlockQuery{cc: cc, done: make(chan struct{})}
+}
+
+func (q *bestBlockQuery) get(ctx context.Context) (*types.BlockInfo, error) {
+	q.once.Do(func() {
+		go func() {
+			defer close(q.done)
+			q.block, q.err = q.cc.QueryBestBlock()
+		}()
+	})
+
+	select {
+	case <-q.done:
+		return q.block, q.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (app *FinalityProviderApp) checkEOTSManager(ctx context.Context) error {
+	// the in-process EOTS manager is always reachable
+	pinger, ok := app.eotsManager.(interface {
+		PingContext(ctx context.Context) error
+	})
+	if !ok {
+		return nil
+	}
+
+	if err := pinger.PingContext(ctx); err != nil {
+		return fmt.Errorf("the EOTS manager is unreachable: %w", err)
+	}
+
+	return nil
+}
+
+func (app *FinalityProviderApp) checkConsumerChain(ctx context.Context, bestBlock *bestBlockQuery) error {
+	if _, err := bestBlock.get(ctx); err != nil {
+		return fmt.Errorf("the consumer chain is unreachable: %w", err)
+	}
+
+	return nil
+}
+
+func (app *FinalityProviderApp) checkFinalityProviders(ctx context.Context, bestBlock *bestBlockQuery) error {
+	fpis := app.fpManager.ListFinalityProviderInstances()
+	if len(fpis) == 0 {
+		return nil
+	}
+
+	tip, err := bestBlock.get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query the best block of the consumer chain: %w", err)
+	}
+
+	var errs []error
+	for _, fpi := range fpis {
+		if !fpi.IsPolling() {
+			errs = append(errs, fmt.Errorf("the finality-provider %s is not polling the consumer chain", fpi.GetBtcPkHex()))
+			continue
+		}
+
+		lastProcessedHeight := fpi.GetLastProcessedHeight()
+		if tip.Height > lastProcessedHeight+app.config.MaxReadinessLag {
+			errs = append(errs, fmt.Errorf("the finality-provider %s is lagging at height %d behind the tip %d",
+				fpi.GetBtcPkHex(), lastProcessedHeight, tip.Height))
+		}
+	}
+
+	return errors.Join(errs...)
+}
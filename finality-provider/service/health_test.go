@@ -0,0 +1,99 @@
+package service_test
+
+import (
+	"context"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/clientcontroller"
+	"github.com/babylonlabs-io/finality-provider/metrics"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+// FuzzReadinessChecks tests the daemon is ready only if the running
+// finality-provider instances poll the consumer chain without lagging
+func FuzzReadinessChecks(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 5)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		randomStartingHeight := uint64(r.Int63n(100) + 1)
+		currentHeight := randomStartingHeight + uint64(r.Int63n(10)+1)
+		mockClientController := testutil.PrepareMockedClientController(t, r, randomStartingHeight, currentHeight)
+		mockClientController.EXPECT().QueryLatestFinalizedBlocks(gomock.Any()).Return(nil, nil).AnyTimes()
+		mockClientController.EXPECT().QueryLastCommittedPublicRand(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		mockClientController.EXPECT().CommitPubRandList(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&types.TxResponse{}, nil).AnyTimes()
+		mockClientController.EXPECT().QueryFinalityProviderSlashed(gomock.Any()).Return(false, nil).AnyTimes()
+		// the blocks are processed without voting
+		mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), gomock.Any()).Return(uint64(0), nil).AnyTimes()
+		app, fpIns, cleanUp := startFinalityProviderAppWithRegisteredFp(t, r, mockClientController, randomStartingHeight)
+		defer cleanUp()
+		app.GetConfig().MaxReadinessLag = 0
+
+		// ready without any running finality-provider instance
+		report := metrics.CheckReadiness(context.Background(), app.ReadinessChecks())
+		require.True(t, report.Ready, report.Checks)
+
+		// ready once the running instance catches up with the tip
+		err := app.StartHandlingFinalityProvider(fpIns.GetBtcPkBIP340(), passphrase)
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			return metrics.CheckReadiness(context.Background(), app.ReadinessChecks()).Ready
+		}, eventuallyWaitTimeOut, eventuallyPollTime)
+
+		// not ready once the instance stops polling
+		err = app.ListFinalityProviderInstances()[0].Stop()
+		require.NoError(t, err)
+		report = metrics.CheckReadiness(context.Background(), app.ReadinessChecks())
+		require.False(t, report.Ready)
+		require.Equal(t, "ok", report.Checks["eots_manager"])
+		require.Equal(t, "ok", report.Checks["consumer_chain"])
+		require.Contains(t, report.Checks["finality_providers"], "not polling")
+	})
+}
+
+// stalledClientController hangs on querying the best block while it is stalled
+type stalledClientController struct {
+	clientcontroller.ClientController
+	stalled atomic.Bool
+	resume  chan struct{}
+}
+
+func (cc *stalledClientController) QueryBestBlock() (*types.BlockInfo, error) {
+	if cc.stalled.Load() {
+		<-cc.resume
+	}
+
+	return cc.ClientController.QueryBestBlock()
+}
+
+// TestReadinessChecksStalledConsumerChain tests the daemon is not ready within
+// the deadline of the probe if the consumer chain does not respond
+func TestReadinessChecksStalledConsumerChain(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	randomStartingHeight := uint64(r.Int63n(100) + 1)
+	mockClientController := testutil.PrepareMockedClientController(t, r, randomStartingHeight, randomStartingHeight)
+	cc := &stalledClientController{ClientController: mockClientController, resume: make(chan struct{})}
+	app, _, cleanUp := startFinalityProviderAppWithRegisteredFp(t, r, cc, randomStartingHeight)
+	defer cleanUp()
+	defer close(cc.resume)
+
+	cc.stalled.Store(true)
+	timeout := 200 * time.Millisecond
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	start := time.Now()
+	report := metrics.CheckReadiness(ctx, app.ReadinessChecks())
+	require.Less(t, time.Since(start), 2*timeout)
+	require.False(t, report.Ready)
+	require.Equal(t, "ok", report.Checks["eots_manager"])
+	require.Contains(t, report.Checks["consumer_chain"], context.DeadlineExceeded.Error())
+}
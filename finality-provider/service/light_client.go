@@ -0,0 +1,212 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/light"
+	"github.com/cometbft/cometbft/light/provider"
+	lighthttp "github.com/cometbft/cometbft/light/provider/http"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"go.uber.org/zap"
+
+	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/store"
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+const (
+	// lightClientInitTimeout is the deadline of fetching the trusted header
+	// when the light client is created
+	lightClientInitTimeout = time.Minute
+	// lightClientVerifyTimeout is the deadline of verifying a header
+	lightClientVerifyTimeout = 30 * time.Second
+)
+
+// HeaderVerifier verifies the blocks against the headers of the consumer chain
+// before they are voted for
+type HeaderVerifier interface {
+	// VerifyBlock returns ErrUnverifiedBlock if the block is proven not to match
+	// the verified header at its height, and other errors if the header cannot
+	// be verified at the moment
+	VerifyBlock(b *types.BlockInfo) error
+}
+
+// LightClientVerifier verifies the blocks against the headers verified by a
+// CometBFT light client, which checks that the headers are signed by the
+// validator set of the consumer chain starting from a trusted header. The
+// verified headers are persisted so that the light client resumes from them
+// after restarting
+type LightClientVerifier struct {
+	mu sync.Mutex
+
+	chainID        string
+	trustingPeriod time.Duration
+	pruningSize    uint16
+	rpcAddr        string
+	witnessAddrs   []string
+	lbStore        *store.LightBlockStore
+	client         *light.Client
+	// newProvider creates the light client provider of an rpc server
+	newProvider providerFactory
+
+	logger *zap.Logger
+}
+
+type providerFactory func(chainID, addr string) (provider.Provider, error)
+
+var _ HeaderVerifier = &LightClientVerifier{}
+
+func NewLightClientVerifier(
+	cfg *fpcfg.LightClientConfig,
+	chainID string,
+	rpcAddr string,
+	db kvdb.Backend,
+	logger *zap.Logger,
+) (*LightClientVerifier, error) {
+	return newLightClientVerifier(cfg, chainID, rpcAddr, db, lighthttp.New, logger)
+}
+
+func newLightClientVerifier(
+	cfg *fpcfg.LightClientConfig,
+	chainID string,
+	rpcAddr string,
+	db kvdb.Backend,
+	newProvider providerFactory,
+	logger *zap.Logger,
+) (*LightClientVerifier, error) {
+	trustedHash, err := cfg.TrustedHashBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	lbStore, err := store.NewLightBlockStore(db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initiate light block store: %w", err)
+	}
+
+	witnessAddrs := cfg.Witnesses
+	if len(witnessAddrs) == 0 {
+		witnessAddrs = []string{rpcAddr}
+	}
+
+	v := &LightClientVerifier{
+		chainID:        chainID,
+		trustingPeriod: cfg.TrustingPeriod,
+		pruningSize:    cfg.PruningSize,
+		rpcAddr:        rpcAddr,
+		witnessAddrs:   witnessAddrs,
+		lbStore:        lbStore,
+		newProvider:    newProvider,
+		logger:         logger,
+	}
+
+	primary, witnesses, err := v.newProviders()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), lightClientInitTimeout)
+	defer cancel()
+	trustOptions := light.TrustOptions{
+		Period: cfg.TrustingPeriod,
+		Height: int64(cfg.TrustedHeight),
+		Hash:   trustedHash,
+	}
+	// the trusted header is only fetched if no higher header has been verified
+	v.client, err = light.NewClient(ctx, chainID, trustOptions, primary, witnesses, lbStore, light.PruningSize(cfg.PruningSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the light client: %w", err)
+	}
+
+	return v, nil
+}
+
+// VerifyBlock verifies that the hash of the block is the app hash of the
+// header at its height verified by the light client
+func (v *LightClientVerifier) VerifyBlock(b *types.BlockInfo) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.client == nil {
+		if err := v.resetClient(); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), lightClientVerifyTimeout)
+	defer cancel()
+	lb, err := v.client.VerifyLightBlockAtHeight(ctx, int64(b.Height), time.Now())
+	if err != nil {
+		if isLightClientVerificationFailure(err) {
+			return fmt.Errorf("%w: the header at height %d: %w", ErrUnverifiedBlock, b.Height, err)
+		}
+		// the light client drops the rpc servers that fail to respond,
+		// so it is re-created from the verified headers once all are dropped
+		if errors.Is(err, light.ErrNoWitnesses) {
+			v.client = nil
+		}
+		return fmt.Errorf("failed to verify the header at height %d: %w", b.Height, err)
+	}
+
+	if !bytes.Equal(lb.AppHash, b.Hash) {
+		return fmt.Errorf("%w: block hash %x at height %d, while the verified app hash is %x",
+			ErrUnverifiedBlock, b.Hash, b.Height, lb.AppHash.Bytes())
+	}
+
+	return nil
+}
+
+// resetClient re-creates the light client from the verified headers
+func (v *LightClientVerifier) resetClient() error {
+	primary, witnesses, err := v.newProviders()
+	if err != nil {
+		return err
+	}
+
+	client, err := light.NewClientFromTrustedStore(v.chainID, v.trustingPeriod, primary, witnesses, v.lbStore, light.PruningSize(v.pruningSize))
+	if err != nil {
+		return fmt.Errorf("failed to re-create the light client: %w", err)
+	}
+	v.client = client
+
+	v.logger.Info("the light client is re-created from the verified headers")
+
+	return nil
+}
+
+func (v *LightClientVerifier) newProviders() (provider.Provider, []provider.Provider, error) {
+	primary, err := v.newProvider(v.chainID, v.rpcAddr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create the light client provider for %s: %w", v.rpcAddr, err)
+	}
+
+	witnesses := make([]provider.Provider, 0, len(v.witnessAddrs))
+	for _, addr := range v.witnessAddrs {
+		w, err := v.newProvider(v.chainID, addr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create the light client witness for %s: %w", addr, err)
+		}
+		witnesses = append(witnesses, w)
+	}
+
+	return primary, witnesses, nil
+}
+
+// isLightClientVerificationFailure returns whether the light client proves that
+// the header is invalid or forged, or that it can no longer verify any header
+// without a new trusted header, rather than failing to fetch the header
+func isLightClientVerificationFailure(err error) bool {
+	var (
+		invalidHeaderErr light.ErrInvalidHeader
+		expiredErr       light.ErrOldHeaderExpired
+	)
+
+	return errors.Is(err, light.ErrLightClientAttack) ||
+		errors.As(err, &invalidHeaderErr) ||
+		errors.As(err, &expiredErr)
+}
@@ -0,0 +1,204 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/cometbft/cometbft/light"
+	"github.com/cometbft/cometbft/light/provider"
+	"github.com/cometbft/cometbft/light/provider/mock"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/service"
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+const (
+	lightClientTestChainID = "test-chain"
+	primaryAddr            = "primary"
+	witnessAddr            = "witness"
+)
+
+// lightChain is a chain of headers signed by the same validators, whose app
+// hashes are the hashes of the blocks voted for
+type lightChain struct {
+	valSet   *cmttypes.ValidatorSet
+	privVals []cmttypes.PrivValidator
+	headers  map[int64]*cmttypes.SignedHeader
+	vals     map[int64]*cmttypes.ValidatorSet
+}
+
+func newLightChain(t *testing.T, r *rand.Rand, numBlocks int64, startTime time.Time) *lightChain {
+	valSet, privVals := cmttypes.RandValidatorSet(4, 10)
+	c := &lightChain{
+		valSet:   valSet,
+		privVals: privVals,
+		headers:  make(map[int64]*cmttypes.SignedHeader),
+		vals:     make(map[int64]*cmttypes.ValidatorSet),
+	}
+	for h := int64(1); h <= numBlocks; h++ {
+		c.setBlock(t, h, datagen.GenRandomByteArray(r, 32), startTime.Add(time.Duration(h)*time.Minute))
+	}
+
+	return c
+}
+
+// fork returns a copy of the chain whose blocks from the given height are
+// replaced by the blocks of different app hashes signed by the same validators
+func (c *lightChain) fork(t *testing.T, r *rand.Rand, fromHeight int64) *lightChain {
+	forked := &lightChain{
+		valSet:   c.valSet,
+		privVals: c.privVals,
+		headers:  make(map[int64]*cmttypes.SignedHeader),
+		vals:     make(map[int64]*cmttypes.ValidatorSet),
+	}
+	for h, sh := range c.headers {
+		if h < fromHeight {
+			forked.headers[h] = sh
+			forked.vals[h] = c.vals[h]
+			continue
+		}
+		forked.setBlock(t, h, datagen.GenRandomByteArray(r, 32), sh.Time)
+	}
+
+	return forked
+}
+
+func (c *lightChain) setBlock(t *testing.T, height int64, appHash []byte, blockTime time.Time) {
+	header := &cmttypes.Header{
+		Version:            cmtversion.Consensus{Block: version.BlockProtocol},
+		ChainID:            lightClientTestChainID,
+		Height:             height,
+		Time:               blockTime,
+		ValidatorsHash:     c.valSet.Hash(),
+		NextValidatorsHash: c.valSet.Hash(),
+		AppHash:            appHash,
+		ProposerAddress:    c.valSet.Validators[0].Address,
+	}
+	blockID := cmttypes.BlockID{
+		Hash:          header.Hash(),
+		PartSetHeader: cmttypes.PartSetHeader{Total: 1, Hash: make([]byte, 32)},
+	}
+	voteSet := cmttypes.NewVoteSet(lightClientTestChainID, height, 1, cmtproto.PrecommitType, c.valSet)
+	extCommit, err := cmttypes.MakeExtCommit(blockID, height, 1, voteSet, c.privVals, blockTime, false)
+	require.NoError(t, err)
+
+	c.headers[height] = &cmttypes.SignedHeader{Header: header, Commit: extCommit.ToCommit()}
+	c.vals[height] = c.valSet
+}
+
+func (c *lightChain) block(height int64) *types.BlockInfo {
+	return &types.BlockInfo{Height: uint64(height), Hash: c.headers[height].AppHash}
+}
+
+// faultyProvider serves bad light blocks while it is faulty, which makes the
+// light client drop it
+type faultyProvider struct {
+	provider.Provider
+	faulty *atomic.Bool
+}
+
+func (p *faultyProvider) LightBlock(ctx context.Context, height int64) (*cmttypes.LightBlock, error) {
+	if p.faulty.Load() {
+		return nil, provider.ErrBadLightBlock{Reason: errors.New("faulty provider")}
+	}
+
+	return p.Provider.LightBlock(ctx, height)
+}
+
+func newTestLightClientVerifier(
+	t *testing.T,
+	newProvider func(chainID, addr string) (provider.Provider, error),
+	trustedHeader *cmttypes.SignedHeader,
+) *service.LightClientVerifier {
+	fpCfg := fpcfg.DefaultConfigWithHome(filepath.Join(t.TempDir(), "fp-home"))
+	db, err := fpCfg.DatabaseConfig.GetDbBackend()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	lcCfg := fpcfg.DefaultLightClientConfig()
+	lcCfg.TrustedHeight = uint64(trustedHeader.Height)
+	lcCfg.TrustedHash = trustedHeader.Hash().String()
+	lcCfg.Witnesses = []string{witnessAddr}
+
+	v, err := service.NewLightClientVerifierWithProviders(lcCfg, lightClientTestChainID, primaryAddr, db, newProvider, zap.NewNop())
+	require.NoError(t, err)
+
+	return v
+}
+
+func TestLightClientVerifier(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	numBlocks := int64(10)
+	chain := newLightChain(t, r, numBlocks, time.Now().Add(-time.Hour))
+
+	t.Run("valid chain", func(t *testing.T) {
+		v := newTestLightClientVerifier(t, func(chainID, addr string) (provider.Provider, error) {
+			return mock.New(chainID, chain.headers, chain.vals), nil
+		}, chain.headers[1])
+
+		for h := int64(2); h <= numBlocks; h++ {
+			require.NoError(t, v.VerifyBlock(chain.block(h)))
+		}
+
+		// the block hash differs from the verified app hash
+		forgedBlock := &types.BlockInfo{Height: uint64(numBlocks), Hash: datagen.GenRandomByteArray(r, 32)}
+		require.ErrorIs(t, v.VerifyBlock(forgedBlock), service.ErrUnverifiedBlock)
+	})
+
+	t.Run("forged chain", func(t *testing.T) {
+		forkHeight := int64(5)
+		forged := chain.fork(t, r, forkHeight)
+		v := newTestLightClientVerifier(t, func(chainID, addr string) (provider.Provider, error) {
+			if addr == primaryAddr {
+				return mock.New(chainID, forged.headers, forged.vals), nil
+			}
+			return mock.New(chainID, chain.headers, chain.vals), nil
+		}, chain.headers[1])
+
+		require.NoError(t, v.VerifyBlock(forged.block(forkHeight-1)))
+
+		// the witness serves a conflicting header signed by the same validators
+		err := v.VerifyBlock(forged.block(forkHeight))
+		require.ErrorIs(t, err, service.ErrUnverifiedBlock)
+		require.ErrorIs(t, err, light.ErrLightClientAttack)
+	})
+
+	t.Run("recover after all providers are dropped", func(t *testing.T) {
+		primaryFaulty := &atomic.Bool{}
+		var numProviders atomic.Int32
+		v := newTestLightClientVerifier(t, func(chainID, addr string) (provider.Provider, error) {
+			numProviders.Add(1)
+			p := mock.New(chainID, chain.headers, chain.vals)
+			if addr == primaryAddr {
+				return &faultyProvider{Provider: p, faulty: primaryFaulty}, nil
+			}
+			return p, nil
+		}, chain.headers[1])
+		require.Equal(t, int32(2), numProviders.Load())
+
+		// the light client drops the faulty primary and promotes the witness,
+		// after which no witness is left, which is not a verification failure
+		primaryFaulty.Store(true)
+		err := v.VerifyBlock(chain.block(numBlocks))
+		require.ErrorIs(t, err, light.ErrNoWitnesses)
+		require.NotErrorIs(t, err, service.ErrUnverifiedBlock)
+
+		// the light client is re-created with new providers from the verified headers
+		primaryFaulty.Store(false)
+		require.NoError(t, v.VerifyBlock(chain.block(numBlocks)))
+		require.Equal(t, int32(4), numProviders.Load())
+	})
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"fmt"
+	"sync"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+// PollerSubscription receives the blocks retrieved by the chain poller in
+// order from its own next height. Each subscription has its own buffer, so
+// a subscription that falls behind does not block the others and receives
+// the missed blocks once it drains its buffer
+type PollerSubscription struct {
+	cp *ChainPoller
+
+	mu         sync.Mutex
+	nextHeight uint64
+	// haltErr is the critical error of the block at the next height that
+	// cannot be trusted, after which no more blocks are delivered
+	haltErr error
+
+	// buf is filled by the poller without blocking, from which the blocks
+	// are forwarded to blockInfoChan
+	buf             chan *types.BlockInfo
+	blockInfoChan   chan *types.BlockInfo
+	criticalErrChan chan error
+	// skipChan hands the height skipped to over to the forwarding goroutine,
+	// which drops the lower blocks it has taken from the buffer
+	skipChan chan uint64
+
+	isClosed *atomic.Bool
+	wg       sync.WaitGroup
+	quit     chan struct{}
+}
+
+func newPollerSubscription(cp *ChainPoller, startHeight uint64) *PollerSubscription {
+	bufferSize := cp.cfg.BufferSize
+	if bufferSize == 0 {
+		bufferSize = 1
+	}
+
+	return &PollerSubscription{
+		cp:              cp,
+		nextHeight:      startHeight,
+		buf:             make(chan *types.BlockInfo, bufferSize),
+		blockInfoChan:   make(chan *types.BlockInfo),
+		criticalErrChan: make(chan error, 1),
+		skipChan:        make(chan uint64),
+		isClosed:        atomic.NewBool(false),
+		quit:            make(chan struct{}),
+	}
+}
+
+// GetBlockInfoChan returns the read only channel of the blocks in order
+func (s *PollerSubscription) GetBlockInfoChan() <-chan *types.BlockInfo {
+	return s.blockInfoChan
+}
+
+// GetCriticalErrChan returns the channel of the critical error that halts the
+// subscription, i.e., the rpc servers disagree on the hash of the next block,
+// or the block does not match the header verified by the light client. The
+// other subscriptions are not affected until they expect the same block. The
+// poller also reports ErrPollerUnavailable while it keeps failing to retrieve
+// blocks, in which case the subscription is not halted
+func (s *PollerSubscription) GetCriticalErrChan() <-chan error {
+	return s.criticalErrChan
+}
+
+// SkipToHeight drops the buffered blocks so that the next block to receive
+// is at the given height. Once it returns, no block lower than the height
+// is received from the subscription
+func (s *PollerSubscription) SkipToHeight(height uint64) error {
+	if err := s.cp.checkServing(); err != nil {
+		return err
+	}
+	if s.isClosed.Load() {
+		return fmt.Errorf("the poller subscription is closed")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.haltErr != nil {
+		return fmt.Errorf("the poller subscription is halted: %w", s.haltErr)
+	}
+
+	// no need to skip heights if the target height is not higher
+	// than the next height to retrieve
+	if height <= s.nextHeight {
+		return fmt.Errorf(
+			"the target height %d is not higher than the next height %d to retrieve",
+			height, s.nextHeight)
+	}
+
+	// all the buffered blocks are lower than the next height
+	s.nextHeight = height
+drain:
+	for {
+		select {
+		case <-s.buf:
+		default:
+			break drain
+		}
+	}
+
+	// the forwarding goroutine might have taken a lower block from the buffer
+	// concurrently, which it drops once it knows the height
+	select {
+	case s.skipChan <- height:
+	case <-s.quit:
+		return fmt.Errorf("the poller subscription is closed")
+	case <-s.cp.quit:
+		return fmt.Errorf("the chain poller is stopped")
+	}
+
+	s.cp.logger.Debug("the poller subscription has skipped height(s)",
+		zap.Uint64("next_height", height))
+
+	s.cp.wakeUp()
+
+	return nil
+}
+
+// NextHeight returns the height of the next block to be buffered
+func (s *PollerSubscription) NextHeight() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.nextHeight
+}
+
+// Close stops receiving blocks from the poller
+func (s *PollerSubscription) Close() error {
+	if s.isClosed.Swap(true) {
+		return fmt.Errorf("the poller subscription is already closed")
+	}
+
+	s.cp.unsubscribe(s)
+	close(s.quit)
+	s.wg.Wait()
+
+	return nil
+}
+
+// readyHeight returns the next height and whether there is room in the buffer
+// of the subscription that is not halted
+func (s *PollerSubscription) readyHeight() (uint64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.nextHeight, s.haltErr == nil && len(s.buf) < cap(s.buf)
+}
+
+// halt reports the critical error and stops receiving blocks if the
+// subscription expects the block at the given height
+func (s *PollerSubscription) halt(height uint64, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.haltErr != nil || s.nextHeight != height {
+		return
+	}
+
+	s.haltErr = err
+	s.reportCriticalErr(err)
+}
+
+func (s *PollerSubscription) raiseNextHeight(height uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.nextHeight < height {
+		s.nextHeight = height
+	}
+}
+
+// deliver buffers the block if it is the next one of the subscription,
+// unless the buffer is full in which case it is delivered again later
+func (s *PollerSubscription) deliver(height uint64, block *types.BlockInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if height != s.nextHeight {
+		return
+	}
+
+	select {
+	case s.buf <- block:
+		s.nextHeight++
+	default:
+	}
+}
+
+func (s *PollerSubscription) reportCriticalErr(err error) {
+	select {
+	case s.criticalErrChan <- err:
+	default:
+	}
+}
+
+func (s *PollerSubscription) forwardBlocks() {
+	defer s.wg.Done()
+
+	// minHeight is the height skipped to, below which the blocks are dropped
+	var minHeight uint64
+	for {
+		select {
+		case block := <-s.buf:
+			// the poller skips the subscription while its buffer is full
+			if len(s.buf)+1 == cap(s.buf) {
+				s.cp.wakeUp()
+			}
+
+		forward:
+			for block.Height >= minHeight {
+				select {
+				case s.blockInfoChan <- block:
+					break forward
+				case minHeight = <-s.skipChan:
+				case <-s.quit:
+					return
+				case <-s.cp.quit:
+					return
+				}
+			}
+		case minHeight = <-s.skipChan:
+		case <-s.quit:
+			return
+		case <-s.cp.quit:
+			return
+		}
+	}
+}
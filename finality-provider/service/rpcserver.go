@@ -10,12 +10,19 @@ import (
 	bbntypes "github.com/babylonlabs-io/babylon/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/store"
 	"github.com/babylonlabs-io/finality-provider/types"
 	"github.com/babylonlabs-io/finality-provider/version"
 )
 
+// maxQueryVotesLimit is the maximum number of the votes returned by a
+// QueryVotes request, which also applies if the request does not limit them
+const maxQueryVotesLimit = 1000
+
 // rpcServer is the main RPC server for the Finality Provider daemon that handles
 // gRPC incoming requests.
 type rpcServer struct {
@@ -134,6 +141,12 @@ func (r *rpcServer) RegisterFinalityProvider(ctx context.Context, req *proto.Reg
 func (r *rpcServer) AddFinalitySignature(ctx context.Context, req *proto.AddFinalitySignatureRequest) (
 	*proto.AddFinalitySignatureResponse, error) {
 
+	// the conflict check can only be skipped if the daemon itself allows it
+	if req.UnsafeSkipConflictCheck && !r.app.config.UnsafeAllowConflictingSignatures {
+		return nil, status.Error(codes.PermissionDenied,
+			"signing conflicting blocks is disabled, set UnsafeAllowConflictingSignatures in fpd.conf to enable it")
+	}
+
 	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(req.BtcPk)
 	if err != nil {
 		return nil, err
@@ -149,7 +162,7 @@ func (r *rpcServer) AddFinalitySignature(ctx context.Context, req *proto.AddFina
 		Hash:   req.AppHash,
 	}
 
-	txRes, privKey, err := fpi.TestSubmitFinalitySignatureAndExtractPrivKey(b)
+	txRes, privKey, err := fpi.TestSubmitFinalitySignatureAndExtractPrivKey(b, req.UnsafeSkipConflictCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -159,22 +172,10 @@ func (r *rpcServer) AddFinalitySignature(ctx context.Context, req *proto.AddFina
 	// if privKey is not empty, then this BTC finality-provider
 	// has voted for a fork and will be slashed
 	if privKey != nil {
-		localPrivKey, err := r.app.getFpPrivKey(fpPk.MustMarshal())
 		res.ExtractedSkHex = privKey.Key.String()
-		if err != nil {
+		if err := r.app.verifyExtractedPrivKey(fpPk, privKey); err != nil {
 			return nil, err
 		}
-		localSkHex := localPrivKey.Key.String()
-		localSkNegateHex := localPrivKey.Key.Negate().String()
-		if res.ExtractedSkHex == localSkHex {
-			res.LocalSkHex = localSkHex
-		} else if res.ExtractedSkHex == localSkNegateHex {
-			res.LocalSkHex = localSkNegateHex
-		} else {
-			return nil, fmt.Errorf("the finality-provider's BTC private key is extracted but does not match the local key,"+
-				"extrated: %s, local: %s, local-negated: %s",
-				res.ExtractedSkHex, localSkHex, localSkNegateHex)
-		}
 	}
 
 	return res, nil
@@ -218,3 +219,34 @@ func (r *rpcServer) SignMessageFromChainKey(ctx context.Context, req *proto.Sign
 
 	return &proto.SignMessageFromChainKeyResponse{Signature: signature}, nil
 }
+
+// QueryVotes queries the finality votes recorded in the local vote journal
+func (r *rpcServer) QueryVotes(ctx context.Context, req *proto.QueryVotesRequest) (
+	*proto.QueryVotesResponse, error) {
+
+	filter := &store.VoteFilter{
+		FromHeight: req.FromHeight,
+		ToHeight:   req.ToHeight,
+		Limit:      req.Limit,
+	}
+	if filter.Limit == 0 || filter.Limit > maxQueryVotesLimit {
+		filter.Limit = maxQueryVotesLimit
+	}
+	if req.BtcPk != "" {
+		fpPk, err := bbntypes.NewBIP340PubKeyFromHex(req.BtcPk)
+		if err != nil {
+			return nil, err
+		}
+		filter.BtcPk = fpPk.MustToBTCPK()
+	}
+	if filter.ToHeight != 0 && filter.FromHeight > filter.ToHeight {
+		return nil, fmt.Errorf("the from height %d should not be higher than the to height %d", filter.FromHeight, filter.ToHeight)
+	}
+
+	votes, err := r.app.QueryVotes(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.QueryVotesResponse{Votes: votes}, nil
+}
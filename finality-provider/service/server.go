@@ -6,21 +6,31 @@ import (
 	"net"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/signal"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthgrpc "google.golang.org/grpc/health/grpc_health_v1"
 
 	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
 	"github.com/babylonlabs-io/finality-provider/metrics"
 )
 
+// healthCheckInterval is the interval of updating the gRPC health status
+// of the finality provider service from the readiness checks
+const healthCheckInterval = 15 * time.Second
+
 // Server is the main daemon construct for the Finality Provider server. It handles
 // spinning up the RPC sever, the database, and any other components that the
 // Taproot Asset server needs to function.
 type Server struct {
 	started int32
+	// ready is set once the RPC server is listening
+	ready int32
 
 	cfg    *fpcfg.Config
 	logger *zap.Logger
@@ -56,7 +66,7 @@ func (s *Server) RunUntilShutdown() error {
 	if err != nil {
 		return fmt.Errorf("failed to get prometheus address: %w", err)
 	}
-	metricsServer := metrics.Start(promAddr, s.logger)
+	metricsServer := metrics.Start(promAddr, s.logger, s.readinessChecks)
 
 	defer func() {
 		s.logger.Info("Shutdown complete")
@@ -86,11 +96,17 @@ func (s *Server) RunUntilShutdown() error {
 		return fmt.Errorf("failed to register gRPC server: %w", err)
 	}
 
+	healthServer := health.NewServer()
+	healthgrpc.RegisterHealthServer(grpcServer, healthServer)
+	defer healthServer.Shutdown()
+
 	// All the necessary components have been registered, so we can
 	// actually start listening for requests.
 	if err := s.startGrpcListen(grpcServer, []net.Listener{lis}); err != nil {
 		return fmt.Errorf("failed to start gRPC listener: %v", err)
 	}
+	atomic.StoreInt32(&s.ready, 1)
+	go s.healthStatusLoop(healthServer)
 
 	s.logger.Info("Finality Provider Daemon is fully active!")
 
@@ -101,6 +117,50 @@ func (s *Server) RunUntilShutdown() error {
 	return nil
 }
 
+// readinessChecks returns the checks of the /readyz endpoint, which include
+// the RPC server listening on top of the checks of the app
+func (s *Server) readinessChecks() []metrics.ReadinessCheck {
+	rpcCheck := metrics.ReadinessCheck{
+		Name: "rpc_server",
+		Check: func(_ context.Context) error {
+			if atomic.LoadInt32(&s.ready) == 0 {
+				return fmt.Errorf("the RPC server is not listening yet")
+			}
+			return nil
+		},
+	}
+
+	return append([]metrics.ReadinessCheck{rpcCheck}, s.rpcServer.app.ReadinessChecks()...)
+}
+
+// healthStatusLoop periodically updates the gRPC health status of the finality
+// provider service from the readiness checks until the server is shut down. The
+// overall status of the server stays serving as long as the daemon is alive
+func (s *Server) healthStatusLoop(healthServer *health.Server) {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+
+	serviceName := proto.FinalityProviders_ServiceDesc.ServiceName
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), healthCheckInterval)
+		report := metrics.CheckReadiness(ctx, s.readinessChecks())
+		cancel()
+
+		if report.Ready {
+			healthServer.SetServingStatus(serviceName, healthgrpc.HealthCheckResponse_SERVING)
+		} else {
+			healthServer.SetServingStatus(serviceName, healthgrpc.HealthCheckResponse_NOT_SERVING)
+			s.logger.Debug("the finality provider daemon is not ready", zap.Any("checks", report.Checks))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-s.interceptor.ShutdownChannel():
+			return
+		}
+	}
+}
+
 // startGrpcListen starts the GRPC server on the passed listeners.
 func (s *Server) startGrpcListen(grpcServer *grpc.Server, listeners []net.Listener) error {
 
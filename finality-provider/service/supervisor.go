@@ -0,0 +1,259 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/finality-provider/clientcontroller"
+	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
+)
+
+// the sources of the critical errors, which label the restarts in the metrics
+const (
+	critErrSourcePoller     = "poller"
+	critErrSourceVoting     = "voting"
+	critErrSourceFastSync   = "fast_sync"
+	critErrSourceRandomness = "randomness"
+	// critErrSourceStart is the failure to start the instance again
+	critErrSourceStart = "start"
+)
+
+// restartState tracks the restarts of a finality-provider instance
+type restartState struct {
+	// count is the total number of restarts, while consecutive is reset
+	// once the instance runs long enough without critical errors
+	count       uint32
+	consecutive uint32
+	lastReason  string
+	lastRestart time.Time
+	// restarting is set until the instance is started again, during
+	// which its critical errors are ignored
+	restarting bool
+}
+
+// isUnrecoverable returns whether the critical error cannot be resolved by
+// restarting the instance, i.e., the block is proven not to match the header
+// verified by the light client or signing would conflict with a previous vote
+func isUnrecoverable(err error) bool {
+	return errors.Is(err, ErrConflictingBlock) ||
+		errors.Is(err, ErrUnverifiedBlock)
+}
+
+// superviseCriticalErr restarts the finality-provider instance after the
+// critical error, unless the error cannot be resolved by restarts in which
+// case it is escalated according to the exit policy
+func (fpm *FinalityProviderManager) superviseCriticalErr(fpi *FinalityProviderInstance, criticalErr *CriticalError) {
+	if fpm.config.Supervisor.ExitPolicy == fpcfg.ExitPolicyAlways {
+		fpm.logger.Fatal(instanceTerminatingMsg,
+			zap.String("pk", fpi.GetBtcPkHex()), zap.Error(criticalErr.err))
+	}
+
+	if isUnrecoverable(criticalErr.err) {
+		fpm.escalate(fpi, criticalErr.err)
+		return
+	}
+
+	// the rpc servers disagree on a block, which needs to be investigated
+	// before voting again, so only the affected instance stops voting
+	if errors.Is(criticalErr.err, clientcontroller.ErrBlockHashMismatch) {
+		fpm.halt(fpi, criticalErr.err)
+		return
+	}
+
+	if !fpm.beginRestart(fpi.GetBtcPkHex()) {
+		fpm.logger.Debug("the finality-provider instance is already restarting",
+			zap.String("pk", fpi.GetBtcPkHex()), zap.Error(criticalErr.err))
+		return
+	}
+
+	fpm.wg.Add(1)
+	go fpm.restartFinalityProvider(fpi, criticalErr.source, criticalErr.err)
+}
+
+// restartFinalityProvider stops the instance and starts it again after the
+// backoff, which doubles each time the instance fails to start
+func (fpm *FinalityProviderManager) restartFinalityProvider(fpi *FinalityProviderInstance, source string, critErr error) {
+	defer fpm.wg.Done()
+	defer fpm.endRestart(fpi.GetBtcPkHex())
+
+	for {
+		consecutive, ok := fpm.recordRestart(fpi.GetBtcPkHex(), source, critErr)
+		if !ok {
+			fpm.escalate(fpi, fmt.Errorf("the finality-provider failed after %d consecutive restarts: %w",
+				consecutive, critErr))
+			return
+		}
+
+		if err := fpi.stopIfRunning(); err != nil {
+			fpm.logger.Debug("failed to stop the finality-provider instance",
+				zap.String("pk", fpi.GetBtcPkHex()), zap.Error(err))
+		}
+
+		delay := fpm.config.Supervisor.RestartDelay(consecutive - 1)
+		fpm.logger.Warn("restarting the finality-provider instance due to critical error",
+			zap.String("pk", fpi.GetBtcPkHex()),
+			zap.String("reason", source),
+			zap.Uint32("consecutive_restarts", consecutive),
+			zap.Duration("delay", delay),
+			zap.Error(critErr))
+
+		select {
+		case <-time.After(delay):
+		case <-fpm.quit:
+			return
+		}
+
+		err := fpm.startManagedInstance(fpi)
+		if errors.Is(err, errInstanceNotManaged) {
+			fpm.logger.Debug("the finality-provider instance is removed during the restart",
+				zap.String("pk", fpi.GetBtcPkHex()))
+			return
+		}
+		if err == nil {
+			fpm.logger.Info("the finality-provider instance is restarted",
+				zap.String("pk", fpi.GetBtcPkHex()))
+			return
+		}
+
+		source, critErr = critErrSourceStart, err
+	}
+}
+
+var errInstanceNotManaged = errors.New("the finality-provider instance is not managed")
+
+// startManagedInstance starts the instance if it is still managed, e.g., it is
+// not removed after being slashed during the restart. The instance is started
+// without holding the lock as it may take a while if the consumer chain is
+// unreachable, so it is checked again once started
+func (fpm *FinalityProviderManager) startManagedInstance(fpi *FinalityProviderInstance) error {
+	if !fpm.isManaged(fpi) {
+		return errInstanceNotManaged
+	}
+
+	if err := fpi.Start(); err != nil {
+		return err
+	}
+
+	if !fpm.isManaged(fpi) {
+		if err := fpi.stopIfRunning(); err != nil {
+			fpm.logger.Debug("failed to stop the removed finality-provider instance",
+				zap.String("pk", fpi.GetBtcPkHex()), zap.Error(err))
+		}
+		return errInstanceNotManaged
+	}
+
+	return nil
+}
+
+func (fpm *FinalityProviderManager) isManaged(fpi *FinalityProviderInstance) bool {
+	fpm.mu.Lock()
+	defer fpm.mu.Unlock()
+
+	return fpm.fpis[fpi.GetBtcPkHex()] == fpi
+}
+
+// escalate handles the critical error that cannot be resolved by restarts,
+// which exits the daemon unless the exit policy is never, in which case only
+// the affected instance is stopped and removed
+func (fpm *FinalityProviderManager) escalate(fpi *FinalityProviderInstance, err error) {
+	if fpm.config.Supervisor.ExitPolicy != fpcfg.ExitPolicyNever {
+		fpm.logger.Fatal(instanceTerminatingMsg,
+			zap.String("pk", fpi.GetBtcPkHex()), zap.Error(err))
+	}
+
+	fpm.logger.Error("stopping the finality-provider instance due to unrecoverable critical error",
+		zap.String("pk", fpi.GetBtcPkHex()), zap.Error(err))
+
+	if err := fpm.removeFinalityProviderInstance(fpi.GetBtcPkBIP340()); err != nil {
+		fpm.logger.Debug("the finality-provider instance is already removed",
+			zap.String("pk", fpi.GetBtcPkHex()), zap.Error(err))
+	}
+}
+
+// halt stops and removes the instance without exiting the daemon, which can be
+// started again once the error is investigated
+func (fpm *FinalityProviderManager) halt(fpi *FinalityProviderInstance, err error) {
+	fpm.logger.Error("halting the finality-provider instance as the block cannot be trusted",
+		zap.String("pk", fpi.GetBtcPkHex()), zap.Error(err))
+
+	if err := fpm.removeFinalityProviderInstance(fpi.GetBtcPkBIP340()); err != nil {
+		fpm.logger.Debug("the finality-provider instance is already removed",
+			zap.String("pk", fpi.GetBtcPkHex()), zap.Error(err))
+	}
+}
+
+// beginRestart marks the instance as restarting, or returns false if it is
+// already restarting. The consecutive restarts are reset if the instance
+// has run long enough since the last restart
+func (fpm *FinalityProviderManager) beginRestart(pkHex string) bool {
+	fpm.mu.Lock()
+	defer fpm.mu.Unlock()
+
+	state, exists := fpm.restarts[pkHex]
+	if !exists {
+		state = &restartState{}
+		fpm.restarts[pkHex] = state
+	}
+	if state.restarting {
+		return false
+	}
+	if time.Since(state.lastRestart) >= fpm.config.Supervisor.RestartResetAfter {
+		state.consecutive = 0
+	}
+	state.restarting = true
+
+	return true
+}
+
+func (fpm *FinalityProviderManager) endRestart(pkHex string) {
+	fpm.mu.Lock()
+	defer fpm.mu.Unlock()
+
+	if state, exists := fpm.restarts[pkHex]; exists {
+		state.restarting = false
+	}
+}
+
+// recordRestart counts the restart due to the critical error and returns the
+// number of consecutive restarts, or false if they are exhausted
+func (fpm *FinalityProviderManager) recordRestart(pkHex string, source string, err error) (uint32, bool) {
+	fpm.mu.Lock()
+	defer fpm.mu.Unlock()
+
+	state := fpm.restarts[pkHex]
+	maxRestarts := fpm.config.Supervisor.MaxRestarts
+	if maxRestarts != 0 && state.consecutive >= maxRestarts {
+		return state.consecutive, false
+	}
+
+	state.count++
+	state.consecutive++
+	state.lastReason = fmt.Sprintf("%s: %s", source, err.Error())
+	state.lastRestart = time.Now()
+	fpm.metrics.IncrementFpTotalRestarts(pkHex, source)
+
+	return state.consecutive, true
+}
+
+// resetConsecutiveRestarts resets the backoff of the instance started manually,
+// for which the caller should hold the lock
+func (fpm *FinalityProviderManager) resetConsecutiveRestarts(pkHex string) {
+	if state, exists := fpm.restarts[pkHex]; exists {
+		state.consecutive = 0
+	}
+}
+
+// setRestartInfo fills the restarts of the finality provider in its info
+func (fpm *FinalityProviderManager) setRestartInfo(fpInfo *proto.FinalityProviderInfo) {
+	fpm.mu.Lock()
+	defer fpm.mu.Unlock()
+
+	if state, exists := fpm.restarts[fpInfo.BtcPkHex]; exists {
+		fpInfo.RestartCount = state.count
+		fpInfo.LastRestartReason = state.lastReason
+	}
+}
@@ -17,4 +17,10 @@ var (
 
 	// ErrPubRandProofNotFound The finality provider we try update is not found in db
 	ErrPubRandProofNotFound = errors.New("public randomness proof not found")
+
+	// ErrCorruptedLightBlockDb For some reason, db on disk representation have changed
+	ErrCorruptedLightBlockDb = errors.New("light block db is corrupted")
+
+	// ErrConflictingSignedBlock A different block has been signed at the same height
+	ErrConflictingSignedBlock = errors.New("conflicting with the block signed at the same height")
 )
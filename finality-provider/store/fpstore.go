@@ -36,7 +36,19 @@ func NewFinalityProviderStore(db kvdb.Backend) (*FinalityProviderStore, error) {
 
 func (s *FinalityProviderStore) initBuckets() error {
 	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
-		_, err := tx.CreateTopLevelBucket(finalityProviderBucketName)
+		if _, err := tx.CreateTopLevelBucket(finalityProviderBucketName); err != nil {
+			return err
+		}
+
+		if _, err := tx.CreateTopLevelBucket(votesBucketName); err != nil {
+			return err
+		}
+
+		if _, err := tx.CreateTopLevelBucket(votesByHeightBucketName); err != nil {
+			return err
+		}
+
+		_, err := tx.CreateTopLevelBucket(signedBlocksBucketName)
 		return err
 	})
 }
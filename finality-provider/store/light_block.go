@@ -0,0 +1,274 @@
+package store
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	lightstore "github.com/cometbft/cometbft/light/store"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// mapping: height -> light block
+	lightBlockBucketName = []byte("light_blocks")
+)
+
+// LightBlockStore persists the light blocks trusted by the CometBFT light client
+// so that the light client resumes from them after restarting
+type LightBlockStore struct {
+	db kvdb.Backend
+}
+
+var _ lightstore.Store = &LightBlockStore{}
+
+// NewLightBlockStore returns a new store backed by db
+func NewLightBlockStore(db kvdb.Backend) (*LightBlockStore, error) {
+	store := &LightBlockStore{db}
+	if err := store.initBuckets(); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (s *LightBlockStore) initBuckets() error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket(lightBlockBucketName)
+		return err
+	})
+}
+
+func (s *LightBlockStore) SaveLightBlock(lb *cmttypes.LightBlock) error {
+	if lb.Height <= 0 {
+		return fmt.Errorf("the height of the light block should be positive")
+	}
+
+	lbProto, err := lb.ToProto()
+	if err != nil {
+		return fmt.Errorf("invalid light block: %w", err)
+	}
+	lbBytes, err := lbProto.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to encode the light block: %w", err)
+	}
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(lightBlockBucketName)
+		if bucket == nil {
+			return ErrCorruptedLightBlockDb
+		}
+
+		return bucket.Put(lightBlockKey(lb.Height), lbBytes)
+	})
+}
+
+func (s *LightBlockStore) DeleteLightBlock(height int64) error {
+	if height <= 0 {
+		return fmt.Errorf("the height of the light block should be positive")
+	}
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(lightBlockBucketName)
+		if bucket == nil {
+			return ErrCorruptedLightBlockDb
+		}
+
+		return bucket.Delete(lightBlockKey(height))
+	})
+}
+
+func (s *LightBlockStore) LightBlock(height int64) (*cmttypes.LightBlock, error) {
+	if height <= 0 {
+		return nil, fmt.Errorf("the height of the light block should be positive")
+	}
+
+	var lbBytes []byte
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(lightBlockBucketName)
+		if bucket == nil {
+			return ErrCorruptedLightBlockDb
+		}
+
+		lbBytes = copyBytes(bucket.Get(lightBlockKey(height)))
+
+		return nil
+	}, func() {
+		lbBytes = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if lbBytes == nil {
+		return nil, lightstore.ErrLightBlockNotFound
+	}
+
+	return decodeLightBlock(lbBytes)
+}
+
+func (s *LightBlockStore) LastLightBlockHeight() (int64, error) {
+	return s.edgeLightBlockHeight(false)
+}
+
+func (s *LightBlockStore) FirstLightBlockHeight() (int64, error) {
+	return s.edgeLightBlockHeight(true)
+}
+
+// edgeLightBlockHeight returns the first or the last height of the stored
+// light blocks, or -1 if the store is empty
+func (s *LightBlockStore) edgeLightBlockHeight(first bool) (int64, error) {
+	height := int64(-1)
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(lightBlockBucketName)
+		if bucket == nil {
+			return ErrCorruptedLightBlockDb
+		}
+
+		c := bucket.ReadCursor()
+		k, _ := c.Last()
+		if first {
+			k, _ = c.First()
+		}
+		if k != nil {
+			height = int64(binary.BigEndian.Uint64(k))
+		}
+
+		return nil
+	}, func() {
+		height = -1
+	})
+	if err != nil {
+		return -1, err
+	}
+
+	return height, nil
+}
+
+func (s *LightBlockStore) LightBlockBefore(height int64) (*cmttypes.LightBlock, error) {
+	if height <= 0 {
+		return nil, fmt.Errorf("the height of the light block should be positive")
+	}
+
+	var lbBytes []byte
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(lightBlockBucketName)
+		if bucket == nil {
+			return ErrCorruptedLightBlockDb
+		}
+
+		c := bucket.ReadCursor()
+		var v []byte
+		if k, _ := c.Seek(lightBlockKey(height)); k != nil {
+			_, v = c.Prev()
+		} else {
+			// all the light blocks are lower than the height
+			_, v = c.Last()
+		}
+		lbBytes = copyBytes(v)
+
+		return nil
+	}, func() {
+		lbBytes = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if lbBytes == nil {
+		return nil, lightstore.ErrLightBlockNotFound
+	}
+
+	return decodeLightBlock(lbBytes)
+}
+
+// Prune removes the lowest light blocks until there are at most size light blocks
+func (s *LightBlockStore) Prune(size uint16) error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(lightBlockBucketName)
+		if bucket == nil {
+			return ErrCorruptedLightBlockDb
+		}
+
+		numToPrune := countKeys(bucket) - int(size)
+		if numToPrune <= 0 {
+			return nil
+		}
+
+		keys := make([][]byte, 0, numToPrune)
+		c := bucket.ReadCursor()
+		for k, _ := c.First(); k != nil && len(keys) < numToPrune; k, _ = c.Next() {
+			keys = append(keys, copyBytes(k))
+		}
+		for _, k := range keys {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Size returns the number of the stored light blocks, or 0 if the store
+// cannot be read as the interface does not return an error
+func (s *LightBlockStore) Size() uint16 {
+	var size int
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(lightBlockBucketName)
+		if bucket == nil {
+			return ErrCorruptedLightBlockDb
+		}
+
+		size = countKeys(bucket)
+
+		return nil
+	}, func() {
+		size = 0
+	})
+	if err != nil {
+		return 0
+	}
+
+	return uint16(size)
+}
+
+func countKeys(bucket kvdb.RBucket) int {
+	var n int
+	c := bucket.ReadCursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		n++
+	}
+
+	return n
+}
+
+func decodeLightBlock(lbBytes []byte) (*cmttypes.LightBlock, error) {
+	var lbProto cmtproto.LightBlock
+	if err := lbProto.Unmarshal(lbBytes); err != nil {
+		return nil, ErrCorruptedLightBlockDb
+	}
+
+	lb, err := cmttypes.LightBlockFromProto(&lbProto)
+	if err != nil {
+		return nil, ErrCorruptedLightBlockDb
+	}
+
+	return lb, nil
+}
+
+func lightBlockKey(height int64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(height))
+
+	return key
+}
+
+func copyBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	return append([]byte{}, b...)
+}
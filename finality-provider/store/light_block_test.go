@@ -0,0 +1,128 @@
+package store_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	lightstore "github.com/cometbft/cometbft/light/store"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	fpstore "github.com/babylonlabs-io/finality-provider/finality-provider/store"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+// FuzzLightBlockStore tests saving, querying and pruning the light blocks
+func FuzzLightBlockStore(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		cfg := config.DefaultDBConfigWithHomePath(t.TempDir())
+		db, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+		defer db.Close()
+		s, err := fpstore.NewLightBlockStore(db)
+		require.NoError(t, err)
+
+		// the store is empty
+		height, err := s.LastLightBlockHeight()
+		require.NoError(t, err)
+		require.Equal(t, int64(-1), height)
+		_, err = s.LightBlock(1)
+		require.ErrorIs(t, err, lightstore.ErrLightBlockNotFound)
+
+		// save the light blocks with gaps in the reverse order
+		startHeight := r.Int63n(1000) + 1
+		numBlocks := r.Intn(10) + 3
+		var heights []int64
+		lbs := make(map[int64]*cmttypes.LightBlock)
+		for i := 0; i < numBlocks; i++ {
+			h := startHeight + int64(i)*(r.Int63n(5)+1)
+			if i > 0 && h <= heights[i-1] {
+				h = heights[i-1] + 1
+			}
+			heights = append(heights, h)
+			lbs[h] = genLightBlock(t, r, h)
+		}
+		for i := numBlocks - 1; i >= 0; i-- {
+			err = s.SaveLightBlock(lbs[heights[i]])
+			require.NoError(t, err)
+		}
+		require.Equal(t, uint16(numBlocks), s.Size())
+
+		// the light blocks are persisted
+		s, err = fpstore.NewLightBlockStore(db)
+		require.NoError(t, err)
+		first, err := s.FirstLightBlockHeight()
+		require.NoError(t, err)
+		require.Equal(t, heights[0], first)
+		last, err := s.LastLightBlockHeight()
+		require.NoError(t, err)
+		require.Equal(t, heights[numBlocks-1], last)
+		i := r.Intn(numBlocks)
+		lb, err := s.LightBlock(heights[i])
+		require.NoError(t, err)
+		require.Equal(t, lbs[heights[i]].Hash(), lb.Hash())
+
+		// query the light block before a height
+		i = r.Intn(numBlocks-1) + 1
+		lb, err = s.LightBlockBefore(heights[i])
+		require.NoError(t, err)
+		require.Equal(t, heights[i-1], lb.Height)
+		lb, err = s.LightBlockBefore(last + 1)
+		require.NoError(t, err)
+		require.Equal(t, last, lb.Height)
+		_, err = s.LightBlockBefore(first)
+		require.ErrorIs(t, err, lightstore.ErrLightBlockNotFound)
+
+		// prune the lowest light blocks
+		err = s.Prune(2)
+		require.NoError(t, err)
+		require.Equal(t, uint16(2), s.Size())
+		first, err = s.FirstLightBlockHeight()
+		require.NoError(t, err)
+		require.Equal(t, heights[numBlocks-2], first)
+
+		// delete a light block
+		err = s.DeleteLightBlock(last)
+		require.NoError(t, err)
+		_, err = s.LightBlock(last)
+		require.ErrorIs(t, err, lightstore.ErrLightBlockNotFound)
+		require.Equal(t, uint16(1), s.Size())
+	})
+}
+
+func genLightBlock(t *testing.T, r *rand.Rand, height int64) *cmttypes.LightBlock {
+	vals, _ := cmttypes.RandValidatorSet(1, 10)
+	header := &cmttypes.Header{
+		Version:            cmtversion.Consensus{Block: version.BlockProtocol},
+		ChainID:            "chain-test",
+		Height:             height,
+		Time:               time.Now(),
+		ValidatorsHash:     vals.Hash(),
+		NextValidatorsHash: vals.Hash(),
+		AppHash:            datagen.GenRandomByteArray(r, 32),
+		ProposerAddress:    vals.Validators[0].Address,
+	}
+	commit := &cmttypes.Commit{
+		Height: height,
+		BlockID: cmttypes.BlockID{
+			Hash:          header.Hash(),
+			PartSetHeader: cmttypes.PartSetHeader{Total: 1, Hash: datagen.GenRandomByteArray(r, 32)},
+		},
+		Signatures: []cmttypes.CommitSig{{BlockIDFlag: cmttypes.BlockIDFlagAbsent}},
+	}
+	lb := &cmttypes.LightBlock{
+		SignedHeader: &cmttypes.SignedHeader{Header: header, Commit: commit},
+		ValidatorSet: vals,
+	}
+	require.NoError(t, lb.ValidateBasic("chain-test"))
+
+	return lb
+}
@@ -0,0 +1,73 @@
+package store
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+var (
+	// mapping btc_pk || height -> block hash
+	// NOTE: the signed blocks are never pruned, as they guard the key against
+	// being leaked by signing a conflicting block at any height signed before
+	signedBlocksBucketName = []byte("signedBlocks")
+)
+
+// SaveSignedBlocks records the hashes of the blocks that the finality provider
+// is about to sign. It fails with ErrConflictingSignedBlock without recording
+// anything if a different hash has been recorded at any of the heights, while
+// recording the same hash again is a no-op
+func (s *FinalityProviderStore) SaveSignedBlocks(pkBytes []byte, blocks []*types.BlockInfo) error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		signedBlocksBucket := tx.ReadWriteBucket(signedBlocksBucketName)
+		if signedBlocksBucket == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		// check all the blocks before recording any of them
+		for _, b := range blocks {
+			signedHash := signedBlocksBucket.Get(voteKey(pkBytes, b.Height, nil))
+			if signedHash != nil && !bytes.Equal(signedHash, b.Hash) {
+				return fmt.Errorf("%w: block hash %x at height %d, while block hash %x has been signed",
+					ErrConflictingSignedBlock, b.Hash, b.Height, signedHash)
+			}
+		}
+
+		for _, b := range blocks {
+			if err := signedBlocksBucket.Put(voteKey(pkBytes, b.Height, nil), b.Hash); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetSignedBlockHash returns the hash of the block signed at the given height,
+// or nil if no block has been signed at the height
+func (s *FinalityProviderStore) GetSignedBlockHash(pkBytes []byte, height uint64) ([]byte, error) {
+	var signedHash []byte
+	err := s.db.View(func(tx kvdb.RTx) error {
+		signedBlocksBucket := tx.ReadBucket(signedBlocksBucketName)
+		if signedBlocksBucket == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		if h := signedBlocksBucket.Get(voteKey(pkBytes, height, nil)); h != nil {
+			signedHash = append([]byte{}, h...)
+		}
+
+		return nil
+	}, func() {
+		signedHash = nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return signedHash, nil
+}
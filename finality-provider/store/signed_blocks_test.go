@@ -0,0 +1,68 @@
+package store_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	fpstore "github.com/babylonlabs-io/finality-provider/finality-provider/store"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+// FuzzSignedBlocks tests that the signed blocks refuse the conflicting ones
+func FuzzSignedBlocks(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		cfg := config.DefaultDBConfigWithHomePath(t.TempDir())
+		fpdb, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+		defer fpdb.Close()
+		s, err := fpstore.NewFinalityProviderStore(fpdb)
+		require.NoError(t, err)
+
+		fp1 := testutil.GenRandomFinalityProvider(r, t)
+		fp2 := testutil.GenRandomFinalityProvider(r, t)
+		pk1 := schnorr.SerializePubKey(fp1.BtcPk)
+		pk2 := schnorr.SerializePubKey(fp2.BtcPk)
+		startHeight := uint64(r.Int63n(1000) + 1)
+		numBlocks := uint64(r.Int63n(20) + 2)
+
+		var blocks []*types.BlockInfo
+		for h := startHeight; h < startHeight+numBlocks; h++ {
+			blocks = append(blocks, &types.BlockInfo{Height: h, Hash: datagen.GenRandomByteArray(r, 32)})
+		}
+		err = s.SaveSignedBlocks(pk1, blocks)
+		require.NoError(t, err)
+
+		// signing the same block again is allowed
+		sameBlock := blocks[r.Intn(len(blocks))]
+		err = s.SaveSignedBlocks(pk1, []*types.BlockInfo{sameBlock})
+		require.NoError(t, err)
+
+		// a batch containing a conflicting block is refused as a whole
+		conflictingBlock := &types.BlockInfo{
+			Height: blocks[r.Intn(len(blocks))].Height,
+			Hash:   datagen.GenRandomByteArray(r, 32),
+		}
+		newBlock := &types.BlockInfo{Height: startHeight + numBlocks, Hash: datagen.GenRandomByteArray(r, 32)}
+		err = s.SaveSignedBlocks(pk1, []*types.BlockInfo{newBlock, conflictingBlock})
+		require.ErrorIs(t, err, fpstore.ErrConflictingSignedBlock)
+		signedHash, err := s.GetSignedBlockHash(pk1, newBlock.Height)
+		require.NoError(t, err)
+		require.Nil(t, signedHash)
+		signedHash, err = s.GetSignedBlockHash(pk1, conflictingBlock.Height)
+		require.NoError(t, err)
+		require.Equal(t, blocks[conflictingBlock.Height-startHeight].Hash, signedHash)
+
+		// the signed blocks of another finality provider are separate
+		err = s.SaveSignedBlocks(pk2, []*types.BlockInfo{conflictingBlock})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,210 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightningnetwork/lnd/kvdb"
+	pm "google.golang.org/protobuf/proto"
+
+	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
+)
+
+var (
+	// mapping btc_pk || height || block_hash -> proto.VoteRecord
+	votesBucketName = []byte("votes")
+	// mapping height || btc_pk || block_hash -> nil, which indexes the votes by height
+	votesByHeightBucketName = []byte("votes_by_height")
+)
+
+// VoteFilter filters the votes of the vote journal, the zero values of
+// the fields do not filter anything
+type VoteFilter struct {
+	BtcPk      *btcec.PublicKey
+	FromHeight uint64
+	ToHeight   uint64
+	Limit      uint32
+}
+
+// AddVotes records the submitted votes in the vote journal. The votes are keyed
+// by the block hash as well, so a vote for a conflicting block at the same height
+// of the same finality provider is recorded besides the previous one, while a
+// vote for the same block overwrites the previous one
+func (s *FinalityProviderStore) AddVotes(votes []*proto.VoteRecord) error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		votesBucket := tx.ReadWriteBucket(votesBucketName)
+		if votesBucket == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		heightIndex := tx.ReadWriteBucket(votesByHeightBucketName)
+		if heightIndex == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		for _, v := range votes {
+			voteBytes, err := pm.Marshal(v)
+			if err != nil {
+				return fmt.Errorf("failed to encode the vote: %w", err)
+			}
+			if err := votesBucket.Put(voteKey(v.BtcPk, v.Height, v.BlockHash), voteBytes); err != nil {
+				return err
+			}
+			if err := heightIndex.Put(voteHeightKey(v.Height, v.BtcPk, v.BlockHash), nil); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// QueryVotes returns the votes matching the filter in the ascending order of
+// height. The votes are scanned from the from height and the scan stops once
+// the limit or the to height is reached
+func (s *FinalityProviderStore) QueryVotes(filter *VoteFilter) ([]*proto.VoteRecord, error) {
+	var votes []*proto.VoteRecord
+	err := s.db.View(func(tx kvdb.RTx) error {
+		votesBucket := tx.ReadBucket(votesBucketName)
+		if votesBucket == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		// collect adds the vote and returns whether the scan should stop
+		collect := func(height uint64, voteBytes []byte) (bool, error) {
+			if filter.ToHeight != 0 && height > filter.ToHeight {
+				return true, nil
+			}
+
+			var vote proto.VoteRecord
+			if err := pm.Unmarshal(voteBytes, &vote); err != nil {
+				return false, ErrCorruptedFinalityProviderDb
+			}
+			votes = append(votes, &vote)
+
+			return filter.Limit > 0 && len(votes) >= int(filter.Limit), nil
+		}
+
+		// the votes of a finality provider are stored in the order of height
+		if filter.BtcPk != nil {
+			pkBytes := schnorr.SerializePubKey(filter.BtcPk)
+			c := votesBucket.ReadCursor()
+			for k, v := c.Seek(voteKey(pkBytes, filter.FromHeight, nil)); k != nil && bytes.HasPrefix(k, pkBytes); k, v = c.Next() {
+				stop, err := collect(binary.BigEndian.Uint64(k[len(pkBytes):len(pkBytes)+8]), v)
+				if err != nil {
+					return err
+				}
+				if stop {
+					break
+				}
+			}
+
+			return nil
+		}
+
+		// the votes of all the finality providers are found through the height index
+		heightIndex := tx.ReadBucket(votesByHeightBucketName)
+		if heightIndex == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+		c := heightIndex.ReadCursor()
+		for k, _ := c.Seek(voteHeightKey(filter.FromHeight, nil, nil)); k != nil; k, _ = c.Next() {
+			if len(k) < 8+schnorr.PubKeyBytesLen {
+				return ErrCorruptedFinalityProviderDb
+			}
+			height := binary.BigEndian.Uint64(k[:8])
+			pkBytes, blockHash := k[8:8+schnorr.PubKeyBytesLen], k[8+schnorr.PubKeyBytesLen:]
+			v := votesBucket.Get(voteKey(pkBytes, height, blockHash))
+			if v == nil {
+				return ErrCorruptedFinalityProviderDb
+			}
+			stop, err := collect(height, v)
+			if err != nil {
+				return err
+			}
+			if stop {
+				break
+			}
+		}
+
+		return nil
+	}, func() {
+		votes = nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return votes, nil
+}
+
+// PruneVotes removes the votes submitted before the given time and
+// returns the number of removed votes
+func (s *FinalityProviderStore) PruneVotes(before time.Time) (int, error) {
+	var numPruned int
+	err := kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		numPruned = 0
+		votesBucket := tx.ReadWriteBucket(votesBucketName)
+		if votesBucket == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		heightIndex := tx.ReadWriteBucket(votesByHeightBucketName)
+		if heightIndex == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		// the votes are not stored in time order, as a finality provider
+		// may catch up with lower heights later
+		var prunedVotes []*proto.VoteRecord
+		c := votesBucket.ReadCursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			var vote proto.VoteRecord
+			if err := pm.Unmarshal(v, &vote); err != nil {
+				return ErrCorruptedFinalityProviderDb
+			}
+			if vote.Timestamp < before.UnixNano() {
+				prunedVotes = append(prunedVotes, &vote)
+			}
+		}
+
+		for _, v := range prunedVotes {
+			if err := votesBucket.Delete(voteKey(v.BtcPk, v.Height, v.BlockHash)); err != nil {
+				return err
+			}
+			if err := heightIndex.Delete(voteHeightKey(v.Height, v.BtcPk, v.BlockHash)); err != nil {
+				return err
+			}
+		}
+		numPruned = len(prunedVotes)
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return numPruned, nil
+}
+
+func voteKey(pkBytes []byte, height uint64, blockHash []byte) []byte {
+	key := make([]byte, 0, len(pkBytes)+8+len(blockHash))
+	key = append(key, pkBytes...)
+	key = binary.BigEndian.AppendUint64(key, height)
+
+	return append(key, blockHash...)
+}
+
+func voteHeightKey(height uint64, pkBytes []byte, blockHash []byte) []byte {
+	key := make([]byte, 0, 8+len(pkBytes)+len(blockHash))
+	key = binary.BigEndian.AppendUint64(key, height)
+	key = append(key, pkBytes...)
+
+	return append(key, blockHash...)
+}
@@ -0,0 +1,136 @@
+package store_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
+	fpstore "github.com/babylonlabs-io/finality-provider/finality-provider/store"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+// FuzzVoteJournal tests recording, querying and pruning the votes
+func FuzzVoteJournal(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		cfg := config.DefaultDBConfigWithHomePath(t.TempDir())
+		fpdb, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+		defer fpdb.Close()
+		s, err := fpstore.NewFinalityProviderStore(fpdb)
+		require.NoError(t, err)
+
+		fp1 := testutil.GenRandomFinalityProvider(r, t)
+		fp2 := testutil.GenRandomFinalityProvider(r, t)
+		startHeight := uint64(r.Int63n(1000) + 1)
+		numVotes := uint64(r.Int63n(20) + 2)
+		oldTime := time.Now().Add(-time.Hour)
+
+		// fp1 votes for each height with a single submission, and fp2 votes
+		// for the same heights in a batch, where the first half of the votes
+		// are submitted earlier
+		var singleVotes, batchedVotes []*proto.VoteRecord
+		for h := startHeight; h < startHeight+numVotes; h++ {
+			timestamp := time.Now().UnixNano()
+			if h < startHeight+numVotes/2 {
+				timestamp = oldTime.UnixNano()
+			}
+			singleVotes = append(singleVotes, genVoteRecord(r, schnorr.SerializePubKey(fp1.BtcPk), h, timestamp, false))
+			batchedVotes = append(batchedVotes, genVoteRecord(r, schnorr.SerializePubKey(fp2.BtcPk), h, timestamp, true))
+		}
+		// record in the reverse order to check the votes are ordered by height
+		for i := len(singleVotes) - 1; i >= 0; i-- {
+			err = s.AddVotes([]*proto.VoteRecord{singleVotes[i]})
+			require.NoError(t, err)
+		}
+		err = s.AddVotes(batchedVotes)
+		require.NoError(t, err)
+
+		// query the votes of a finality provider
+		votes, err := s.QueryVotes(&fpstore.VoteFilter{BtcPk: fp1.BtcPk})
+		require.NoError(t, err)
+		require.Len(t, votes, int(numVotes))
+		for i, v := range votes {
+			require.Equal(t, singleVotes[i].Height, v.Height)
+			require.Equal(t, singleVotes[i].BlockHash, v.BlockHash)
+			require.Equal(t, singleVotes[i].PubRand, v.PubRand)
+			require.Equal(t, singleVotes[i].TxHash, v.TxHash)
+			require.False(t, v.Batched)
+		}
+
+		// query the votes of all the finality providers within a range of heights
+		fromHeight := startHeight + uint64(r.Int63n(int64(numVotes)))
+		toHeight := fromHeight + uint64(r.Int63n(int64(startHeight+numVotes-fromHeight)))
+		votes, err = s.QueryVotes(&fpstore.VoteFilter{FromHeight: fromHeight, ToHeight: toHeight})
+		require.NoError(t, err)
+		require.Len(t, votes, 2*int(toHeight-fromHeight+1))
+		for i, v := range votes {
+			require.GreaterOrEqual(t, v.Height, fromHeight)
+			require.LessOrEqual(t, v.Height, toHeight)
+			if i > 0 {
+				require.GreaterOrEqual(t, v.Height, votes[i-1].Height)
+			}
+		}
+
+		// the number of the votes is limited
+		votes, err = s.QueryVotes(&fpstore.VoteFilter{BtcPk: fp2.BtcPk, FromHeight: fromHeight, Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, votes, 1)
+		require.Equal(t, fromHeight, votes[0].Height)
+		require.True(t, votes[0].Batched)
+
+		// the scan of the votes of all the finality providers stops at the limit
+		limit := uint32(r.Int63n(int64(2*(startHeight+numVotes-fromHeight))) + 1)
+		votes, err = s.QueryVotes(&fpstore.VoteFilter{FromHeight: fromHeight, Limit: limit})
+		require.NoError(t, err)
+		require.Len(t, votes, int(limit))
+		for i, v := range votes {
+			require.Equal(t, fromHeight+uint64(i/2), v.Height)
+		}
+
+		// prune the votes submitted earlier
+		numPruned, err := s.PruneVotes(oldTime.Add(time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, 2*int(numVotes/2), numPruned)
+		votes, err = s.QueryVotes(&fpstore.VoteFilter{})
+		require.NoError(t, err)
+		require.Len(t, votes, 2*int(numVotes-numVotes/2))
+		require.Equal(t, startHeight+numVotes/2, votes[0].Height)
+
+		// a vote for a conflicting block at the same height does not
+		// overwrite the vote for the block signed before
+		lastVote := singleVotes[len(singleVotes)-1]
+		conflictingVote := genVoteRecord(r, lastVote.BtcPk, lastVote.Height, time.Now().UnixNano(), false)
+		err = s.AddVotes([]*proto.VoteRecord{conflictingVote})
+		require.NoError(t, err)
+		votes, err = s.QueryVotes(&fpstore.VoteFilter{BtcPk: fp1.BtcPk, FromHeight: lastVote.Height})
+		require.NoError(t, err)
+		require.Len(t, votes, 2)
+		blockHashes := [][]byte{votes[0].BlockHash, votes[1].BlockHash}
+		require.Contains(t, blockHashes, lastVote.BlockHash)
+		require.Contains(t, blockHashes, conflictingVote.BlockHash)
+		votes, err = s.QueryVotes(&fpstore.VoteFilter{FromHeight: lastVote.Height})
+		require.NoError(t, err)
+		require.Len(t, votes, 3)
+	})
+}
+
+func genVoteRecord(r *rand.Rand, pkBytes []byte, height uint64, timestamp int64, batched bool) *proto.VoteRecord {
+	return &proto.VoteRecord{
+		BtcPk:     pkBytes,
+		Height:    height,
+		BlockHash: datagen.GenRandomByteArray(r, 32),
+		PubRand:   datagen.GenRandomByteArray(r, 32),
+		TxHash:    datagen.GenRandomHexStr(r, 32),
+		Timestamp: timestamp,
+		Batched:   batched,
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	bbntypes "github.com/babylonlabs-io/babylon/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/stretchr/testify/require"
 
@@ -95,11 +96,11 @@ func TestDoubleSigning(t *testing.T) {
 		Height: finalizedBlocks[0].Height,
 		Hash:   datagen.GenRandomByteArray(r, 32),
 	}
-	_, extractedKey, err := fpIns.TestSubmitFinalitySignatureAndExtractPrivKey(b)
+	_, extractedKey, err := fpIns.TestSubmitFinalitySignatureAndExtractPrivKey(b, true)
 	require.NoError(t, err)
 	require.NotNil(t, extractedKey)
-	localKey := tm.GetFpPrivKey(t, fpIns.GetBtcPkBIP340().MustMarshal())
-	require.True(t, localKey.Key.Equals(&extractedKey.Key) || localKey.Key.Negate().Equals(&extractedKey.Key))
+	extractedPk := bbntypes.NewBIP340PubKeyFromBTCPK(extractedKey.PubKey())
+	require.True(t, fpIns.GetBtcPkBIP340().Equals(extractedPk))
 
 	t.Logf("the equivocation attack is successful")
 
@@ -103,9 +103,11 @@ func StartManager(t *testing.T) *TestManager {
 	// 3. prepare EOTS manager
 	eotsHomeDir := filepath.Join(testDir, "eots-home")
 	eotsCfg := eotsconfig.DefaultConfigWithHomePath(eotsHomeDir)
+	// the equivocation test signs conflicting blocks to extract the private key
+	eotsCfg.UnsafeAllowDoubleSign = true
 	eh := NewEOTSServerHandler(t, eotsCfg, eotsHomeDir)
 	eh.Start()
-	eotsCli, err := client.NewEOTSManagerGRpcClient(cfg.EOTSManagerAddress)
+	eotsCli, err := client.NewEOTSManagerGRpcClient(cfg.EOTSManagerAddress, nil, "")
 	require.NoError(t, err)
 
 	// 4. prepare finality-provider
@@ -391,12 +393,6 @@ func (tm *TestManager) StopAndRestartFpAfterNBlocks(t *testing.T, n int, fpIns *
 	require.NoError(t, err)
 }
 
-func (tm *TestManager) GetFpPrivKey(t *testing.T, fpPk []byte) *btcec.PrivateKey {
-	record, err := tm.EOTSClient.KeyRecord(fpPk, passphrase)
-	require.NoError(t, err)
-	return record.PrivKey
-}
-
 func (tm *TestManager) InsertCovenantSigForDelegation(t *testing.T, btcDel *bstypes.BTCDelegation) {
 	slashingTx := btcDel.SlashingTx
 	stakingTx := btcDel.StakingTx
@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -13,6 +14,7 @@ type EotsMetrics struct {
 	EotsFpTotalEotsSignCounter            *prometheus.CounterVec
 	EotsFpLastEotsSignHeight              *prometheus.GaugeVec
 	EotsFpTotalSchnorrSignCounter         *prometheus.CounterVec
+	EotsFpRandomnessDerivationSeconds     *prometheus.HistogramVec
 }
 
 var eotsMetricsRegisterOnce sync.Once
@@ -61,6 +63,14 @@ func NewEotsMetrics() *EotsMetrics {
 				},
 				[]string{"fp_btc_pk_hex"},
 			),
+			EotsFpRandomnessDerivationSeconds: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Name:    "eots_fp_randomness_derivation_seconds",
+					Help:    "Seconds taken by EOTS to derive a list of public randomness, including loading the key",
+					Buckets: prometheus.ExponentialBuckets(0.001, 2, 15),
+				},
+				[]string{"fp_btc_pk_hex"},
+			),
 		}
 
 		// Register the EOTS metrics with Prometheus
@@ -70,6 +80,7 @@ func NewEotsMetrics() *EotsMetrics {
 		prometheus.MustRegister(eotsMetricsInstance.EotsFpTotalEotsSignCounter)
 		prometheus.MustRegister(eotsMetricsInstance.EotsFpLastEotsSignHeight)
 		prometheus.MustRegister(eotsMetricsInstance.EotsFpTotalSchnorrSignCounter)
+		prometheus.MustRegister(eotsMetricsInstance.EotsFpRandomnessDerivationSeconds)
 	})
 
 	return eotsMetricsInstance
@@ -104,3 +115,8 @@ func (em *EotsMetrics) SetEotsFpLastEotsSignHeight(fpBtcPkHex string, height flo
 func (em *EotsMetrics) IncrementEotsFpTotalSchnorrSignCounter(fpBtcPkHex string) {
 	em.EotsFpTotalSchnorrSignCounter.WithLabelValues(fpBtcPkHex).Inc()
 }
+
+// ObserveEotsFpRandomnessDerivationTime records the time taken to derive a list of public randomness
+func (em *EotsMetrics) ObserveEotsFpRandomnessDerivationTime(fpBtcPkHex string, d time.Duration) {
+	em.EotsFpRandomnessDerivationSeconds.WithLabelValues(fpBtcPkHex).Observe(d.Seconds())
+}
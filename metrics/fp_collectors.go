@@ -17,6 +17,8 @@ type FpMetrics struct {
 	babylonTipHeight     prometheus.Gauge
 	lastPolledHeight     prometheus.Gauge
 	pollerStartingHeight prometheus.Gauge
+	pollerLag            prometheus.Gauge
+	pollerMode           *prometheus.GaugeVec
 	// single finality provider metrics
 	fpStatus                        *prometheus.GaugeVec
 	fpSecondsSinceLastVote          *prometheus.GaugeVec
@@ -29,12 +31,23 @@ type FpMetrics struct {
 	fpTotalCommittedRandomness      *prometheus.GaugeVec
 	fpTotalFailedVotes              *prometheus.CounterVec
 	fpTotalFailedRandomness         *prometheus.CounterVec
+	fpTotalRestarts                 *prometheus.CounterVec
+	// EOTS manager client metrics
+	eotsManagerEndpointHealthy *prometheus.GaugeVec
+	eotsManagerActiveEndpoint  *prometheus.GaugeVec
+	eotsManagerFailovers       prometheus.Counter
+	activeEOTSManagerEndpoint  string
 	// time keeper
 	mu                     sync.Mutex
 	previousVoteByFp       map[string]*time.Time
 	previousRandomnessByFp map[string]*time.Time
 }
 
+const (
+	PollerModeTipFollowing = "tip_following"
+	PollerModeCatchUp      = "catch_up"
+)
+
 // Declare a package-level variable for sync.Once to ensure metrics are registered only once
 var fpMetricsRegisterOnce sync.Once
 
@@ -61,6 +74,17 @@ func NewFpMetrics() *FpMetrics {
 				Name: "last_polled_height",
 				Help: "The most recent block height checked by the poller",
 			}),
+			pollerLag: prometheus.NewGauge(prometheus.GaugeOpts{
+				Name: "poller_lag",
+				Help: "The number of blocks between the lowest height to retrieve by the poller and the tip of the consumer chain",
+			}),
+			pollerMode: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Name: "poller_mode",
+					Help: "The current mode of the poller (1), either following the tip or catching up.",
+				},
+				[]string{"mode"},
+			),
 			pollerStartingHeight: prometheus.NewGauge(prometheus.GaugeOpts{
 				Name: "poller_starting_height",
 				Help: "The initial block height when the poller started operation",
@@ -135,6 +159,33 @@ func NewFpMetrics() *FpMetrics {
 				},
 				[]string{"fp_btc_pk_hex"},
 			),
+			fpTotalRestarts: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Name: "fp_total_restarts",
+					Help: "The total number of restarts of a finality provider after critical errors, by the source of the error.",
+				},
+				[]string{"fp_btc_pk_hex", "reason"},
+			),
+			eotsManagerEndpointHealthy: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Name: "eots_manager_endpoint_healthy",
+					Help: "Whether an EOTS manager endpoint responded to the last request sent to it (1) or not (0).",
+				},
+				[]string{"endpoint"},
+			),
+			eotsManagerActiveEndpoint: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Name: "eots_manager_active_endpoint",
+					Help: "The EOTS manager endpoint that the requests are sent to (1).",
+				},
+				[]string{"endpoint"},
+			),
+			eotsManagerFailovers: prometheus.NewCounter(
+				prometheus.CounterOpts{
+					Name: "eots_manager_failovers_total",
+					Help: "The total number of failovers between the EOTS manager endpoints.",
+				},
+			),
 			mu: sync.Mutex{},
 		}
 
@@ -144,6 +195,8 @@ func NewFpMetrics() *FpMetrics {
 		prometheus.MustRegister(fpMetricsInstance.babylonTipHeight)
 		prometheus.MustRegister(fpMetricsInstance.lastPolledHeight)
 		prometheus.MustRegister(fpMetricsInstance.pollerStartingHeight)
+		prometheus.MustRegister(fpMetricsInstance.pollerLag)
+		prometheus.MustRegister(fpMetricsInstance.pollerMode)
 		prometheus.MustRegister(fpMetricsInstance.fpSecondsSinceLastVote)
 		prometheus.MustRegister(fpMetricsInstance.fpSecondsSinceLastRandomness)
 		prometheus.MustRegister(fpMetricsInstance.fpLastVotedHeight)
@@ -154,6 +207,10 @@ func NewFpMetrics() *FpMetrics {
 		prometheus.MustRegister(fpMetricsInstance.fpLastCommittedRandomnessHeight)
 		prometheus.MustRegister(fpMetricsInstance.fpTotalFailedVotes)
 		prometheus.MustRegister(fpMetricsInstance.fpTotalFailedRandomness)
+		prometheus.MustRegister(fpMetricsInstance.fpTotalRestarts)
+		prometheus.MustRegister(fpMetricsInstance.eotsManagerEndpointHealthy)
+		prometheus.MustRegister(fpMetricsInstance.eotsManagerActiveEndpoint)
+		prometheus.MustRegister(fpMetricsInstance.eotsManagerFailovers)
 	})
 	return fpMetricsInstance
 }
@@ -188,6 +245,21 @@ func (fm *FpMetrics) RecordPollerStartingHeight(height uint64) {
 	fm.pollerStartingHeight.Set(float64(height))
 }
 
+// RecordPollerLag records the number of blocks the poller is behind the tip
+func (fm *FpMetrics) RecordPollerLag(lag uint64) {
+	fm.pollerLag.Set(float64(lag))
+}
+
+// RecordPollerMode records whether the poller is catching up or following the tip
+func (fm *FpMetrics) RecordPollerMode(catchingUp bool) {
+	var v float64
+	if catchingUp {
+		v = 1
+	}
+	fm.pollerMode.WithLabelValues(PollerModeCatchUp).Set(v)
+	fm.pollerMode.WithLabelValues(PollerModeTipFollowing).Set(1 - v)
+}
+
 // RecordFpSecondsSinceLastVote records the seconds since the last finality sig vote by a finality provider
 func (fm *FpMetrics) RecordFpSecondsSinceLastVote(fpBtcPkHex string, seconds float64) {
 	fm.fpSecondsSinceLastVote.WithLabelValues(fpBtcPkHex).Set(seconds)
@@ -243,6 +315,37 @@ func (fm *FpMetrics) IncrementFpTotalFailedRandomness(fpBtcPkHex string) {
 	fm.fpTotalFailedRandomness.WithLabelValues(fpBtcPkHex).Inc()
 }
 
+// IncrementFpTotalRestarts increments the total number of restarts of a finality provider
+func (fm *FpMetrics) IncrementFpTotalRestarts(fpBtcPkHex string, reason string) {
+	fm.fpTotalRestarts.WithLabelValues(fpBtcPkHex, reason).Inc()
+}
+
+// ObserveEOTSManagerHealth records whether the EOTS manager endpoint is responding
+func (fm *FpMetrics) ObserveEOTSManagerHealth(endpoint string, healthy bool) {
+	var v float64
+	if healthy {
+		v = 1
+	}
+	fm.eotsManagerEndpointHealthy.WithLabelValues(endpoint).Set(v)
+}
+
+// ObserveEOTSManagerActiveEndpoint records the EOTS manager endpoint that the
+// requests are sent to, and counts a failover if it changes
+func (fm *FpMetrics) ObserveEOTSManagerActiveEndpoint(endpoint string) {
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+
+	if fm.activeEOTSManagerEndpoint == endpoint {
+		return
+	}
+	if fm.activeEOTSManagerEndpoint != "" {
+		fm.eotsManagerActiveEndpoint.WithLabelValues(fm.activeEOTSManagerEndpoint).Set(0)
+		fm.eotsManagerFailovers.Inc()
+	}
+	fm.eotsManagerActiveEndpoint.WithLabelValues(endpoint).Set(1)
+	fm.activeEOTSManagerEndpoint = endpoint
+}
+
 // RecordFpVoteTime records the time of a finality sig vote by a finality provider
 func (fm *FpMetrics) RecordFpVoteTime(fpBtcPkHex string) {
 	fm.mu.Lock()
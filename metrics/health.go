@@ -0,0 +1,90 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// readinessTimeout is the deadline of evaluating the readiness checks
+const readinessTimeout = 10 * time.Second
+
+// ReadinessCheck checks a dependency of the daemon, which is not ready
+// to serve if the check returns an error
+type ReadinessCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// ReadinessReport is the result of the readiness checks, which is
+// returned by the /readyz endpoint
+type ReadinessReport struct {
+	Ready bool `json:"ready"`
+	// Checks are the results of the checks keyed by their names,
+	// which are either "ok" or the error of the check
+	Checks map[string]string `json:"checks"`
+}
+
+// CheckReadiness runs the readiness checks concurrently. The daemon is ready
+// if all the checks pass before the context is done, and a check that has not
+// returned by then fails with the error of the context
+func CheckReadiness(ctx context.Context, checks []ReadinessCheck) *ReadinessReport {
+	report := &ReadinessReport{
+		Ready:  true,
+		Checks: make(map[string]string, len(checks)),
+	}
+
+	// the channels are buffered so that a stalled check does not block
+	// forever once its result is abandoned
+	results := make([]chan error, len(checks))
+	for i, c := range checks {
+		results[i] = make(chan error, 1)
+		go func(c ReadinessCheck, res chan<- error) {
+			res <- c.Check(ctx)
+		}(c, results[i])
+	}
+
+	for i, c := range checks {
+		var err error
+		select {
+		case err = <-results[i]:
+		case <-ctx.Done():
+			err = fmt.Errorf("the check did not complete: %w", ctx.Err())
+		}
+
+		if err != nil {
+			report.Ready = false
+			report.Checks[c.Name] = err.Error()
+			continue
+		}
+		report.Checks[c.Name] = "ok"
+	}
+
+	return report
+}
+
+// healthzHandler reports the daemon is alive as long as it serves requests
+func healthzHandler(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok"))
+}
+
+// readyzHandler reports whether the daemon is ready by running the readiness
+// checks created for each request within the timeout, and responds with 503
+// if it is not
+func readyzHandler(checks func() []ReadinessCheck, timeout time.Duration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		report := CheckReadiness(ctx, checks())
+
+		w.Header().Set("Content-Type", "application/json")
+		if !report.Ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(report)
+	}
+}
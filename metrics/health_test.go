@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadyz(t *testing.T) {
+	var depErr error
+	checks := func() []ReadinessCheck {
+		return []ReadinessCheck{
+			{Name: "always", Check: func(_ context.Context) error { return nil }},
+			{Name: "dependency", Check: func(_ context.Context) error { return depErr }},
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	readyzHandler(checks, readinessTimeout)(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var report ReadinessReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	require.True(t, report.Ready)
+	require.Equal(t, map[string]string{"always": "ok", "dependency": "ok"}, report.Checks)
+
+	depErr = fmt.Errorf("unreachable")
+	rec = httptest.NewRecorder()
+	readyzHandler(checks, readinessTimeout)(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	require.False(t, report.Ready)
+	require.Equal(t, "unreachable", report.Checks["dependency"])
+
+	// the daemon is alive regardless of the readiness
+	rec = httptest.NewRecorder()
+	healthzHandler(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestReadyzStalledDependency(t *testing.T) {
+	stalled := make(chan struct{})
+	defer close(stalled)
+	checks := func() []ReadinessCheck {
+		return []ReadinessCheck{
+			{Name: "always", Check: func(_ context.Context) error { return nil }},
+			// the dependency hangs regardless of the context
+			{Name: "stalled", Check: func(_ context.Context) error {
+				<-stalled
+				return nil
+			}},
+			{Name: "slow", Check: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}},
+		}
+	}
+
+	timeout := 200 * time.Millisecond
+	start := time.Now()
+	rec := httptest.NewRecorder()
+	readyzHandler(checks, timeout)(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	// the checks run concurrently, so the delays do not add up
+	require.Less(t, time.Since(start), 2*timeout)
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	var report ReadinessReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	require.False(t, report.Ready)
+	require.Equal(t, "ok", report.Checks["always"])
+	require.Contains(t, report.Checks["stalled"], context.DeadlineExceeded.Error())
+	require.Contains(t, report.Checks["slow"], context.DeadlineExceeded.Error())
+}
@@ -14,9 +14,15 @@ type Server struct {
 	logger     *zap.Logger
 }
 
-func Start(addr string, logger *zap.Logger) *Server {
+// Start starts the metrics server, which also serves the /healthz liveness
+// endpoint and the /readyz readiness endpoint running the checks returned by
+// readinessChecks, which is called for each request so that the checks can
+// share the queries of a single probe
+func Start(addr string, logger *zap.Logger, readinessChecks func() []ReadinessCheck) *Server {
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.Handler())
+	mux.HandleFunc("/healthz", healthzHandler)
+	mux.HandleFunc("/readyz", readyzHandler(readinessChecks, readinessTimeout))
 
 	// Create the HTTP server with the custom ServeMux as the handler
 	server := &http.Server{
@@ -0,0 +1,111 @@
+package testutil
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+// NewBlockEventServer is a local stand-in of the websocket of a CometBFT rpc
+// server, which pushes the NewBlock events to the subscribers
+type NewBlockEventServer struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	conns map[*websocket.Conn]json.RawMessage
+}
+
+func NewNewBlockEventServer(t *testing.T) *NewBlockEventServer {
+	s := &NewBlockEventServer{conns: make(map[*websocket.Conn]json.RawMessage)}
+	upgrader := websocket.Upgrader{}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/websocket" {
+			http.NotFound(w, r)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params map[string]string `json:"params"`
+		}
+		if err := conn.ReadJSON(&req); err != nil || req.Method != "subscribe" ||
+			req.Params["query"] != cmttypes.EventQueryNewBlock.String() {
+			conn.Close()
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if err := conn.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": struct{}{}}); err != nil {
+			conn.Close()
+			return
+		}
+		s.conns[conn] = req.ID
+	}))
+	t.Cleanup(func() {
+		s.DropSubscriptions()
+		s.Close()
+	})
+
+	return s
+}
+
+// RPCAddr returns the rpc address of the server
+func (s *NewBlockEventServer) RPCAddr() string {
+	return s.URL
+}
+
+// WaitForSubscribers waits until the given number of subscribers subscribe
+func (s *NewBlockEventServer) WaitForSubscribers(t *testing.T, n int) {
+	require.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return len(s.conns) >= n
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+// PublishNewBlock sends the NewBlock event of the given height to the subscribers
+func (s *NewBlockEventServer) PublishNewBlock(t *testing.T, height int64) {
+	data, err := cmtjson.Marshal(ctypes.ResultEvent{
+		Query: cmttypes.EventQueryNewBlock.String(),
+		Data: cmttypes.EventDataNewBlock{
+			Block: &cmttypes.Block{Header: cmttypes.Header{Height: height}},
+		},
+	})
+	require.NoError(t, err)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for conn, id := range s.conns {
+		msg := map[string]any{"jsonrpc": "2.0", "id": id, "result": json.RawMessage(data)}
+		if err := conn.WriteJSON(msg); err != nil {
+			conn.Close()
+			delete(s.conns, conn)
+		}
+	}
+}
+
+// DropSubscriptions closes the connections of the subscribers
+func (s *NewBlockEventServer) DropSubscriptions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for conn := range s.conns {
+		conn.Close()
+		delete(s.conns, conn)
+	}
+}
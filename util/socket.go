@@ -0,0 +1,39 @@
+package util
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+	"strings"
+)
+
+// UnixSocketScheme is the prefix of the RPC addresses of unix domain sockets,
+// e.g., unix:///var/run/eotsd.sock
+const UnixSocketScheme = "unix://"
+
+// UnixSocketPath returns the socket path of the RPC address if it is the
+// address of a unix domain socket
+func UnixSocketPath(addr string) (string, bool) {
+	if !strings.HasPrefix(addr, UnixSocketScheme) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(addr, UnixSocketScheme), true
+}
+
+// ValidateRPCAddress checks the RPC address is either a TCP address or the
+// absolute path of a unix domain socket
+func ValidateRPCAddress(addr string) error {
+	if path, ok := UnixSocketPath(addr); ok {
+		if !filepath.IsAbs(path) {
+			return fmt.Errorf("the unix socket path of %s should be absolute", addr)
+		}
+		return nil
+	}
+
+	if _, err := net.ResolveTCPAddr("tcp", addr); err != nil {
+		return fmt.Errorf("invalid TCP address %s: %w", addr, err)
+	}
+
+	return nil
+}
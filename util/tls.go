@@ -0,0 +1,199 @@
+package util
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"time"
+)
+
+// LoadServerTLSConfig loads the TLS config of an RPC server from the given
+// certificate and key files. If clientCAFile is not empty, the server requires
+// and verifies the client certificates against the CA, i.e., mutual TLS
+func LoadServerTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the TLS key pair: %w", err)
+	}
+
+	tlsCfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if clientCAFile != "" {
+		caPool, err := loadCertPool(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsCfg.ClientCAs = caPool
+		tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsCfg, nil
+}
+
+// LoadClientTLSConfig loads the TLS config of an RPC client which verifies the
+// server certificate against the given CA. If certFile and keyFile are not empty,
+// the client presents the certificate to the server for mutual TLS.
+// serverName overrides the host name used to verify the server certificate
+func LoadClientTLSConfig(caFile, certFile, keyFile, serverName string) (*tls.Config, error) {
+	caPool, err := loadCertPool(caFile)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsCfg := &tls.Config{
+		RootCAs:    caPool,
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the TLS key pair: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsCfg, nil
+}
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	caPem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the CA certificate %s: %w", caFile, err)
+	}
+
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caPem) {
+		return nil, fmt.Errorf("no valid CA certificate found in %s", caFile)
+	}
+
+	return caPool, nil
+}
+
+// CertKeyPair is a PEM encoded certificate and its private key
+type CertKeyPair struct {
+	CertPem []byte
+	KeyPem  []byte
+
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// GenerateCA generates a self-signed CA certificate valid for the given duration
+func GenerateCA(commonName string, validFor time.Duration) (*CertKeyPair, error) {
+	tmpl, err := newCertTemplate(commonName, validFor)
+	if err != nil {
+		return nil, err
+	}
+	tmpl.IsCA = true
+	tmpl.BasicConstraintsValid = true
+	tmpl.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature
+
+	return createCertKeyPair(tmpl, nil)
+}
+
+// GenerateServerCert generates a server certificate signed by the given CA.
+// The hosts can be either IP addresses or DNS names
+func GenerateServerCert(ca *CertKeyPair, commonName string, hosts []string, validFor time.Duration) (*CertKeyPair, error) {
+	tmpl, err := newCertTemplate(commonName, validFor)
+	if err != nil {
+		return nil, err
+	}
+	tmpl.KeyUsage = x509.KeyUsageDigitalSignature
+	tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+		} else {
+			tmpl.DNSNames = append(tmpl.DNSNames, h)
+		}
+	}
+
+	return createCertKeyPair(tmpl, ca)
+}
+
+// GenerateClientCert generates a client certificate signed by the given CA
+func GenerateClientCert(ca *CertKeyPair, commonName string, validFor time.Duration) (*CertKeyPair, error) {
+	tmpl, err := newCertTemplate(commonName, validFor)
+	if err != nil {
+		return nil, err
+	}
+	tmpl.KeyUsage = x509.KeyUsageDigitalSignature
+	tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+
+	return createCertKeyPair(tmpl, ca)
+}
+
+// WriteFiles writes the certificate and the private key to the given files
+func (p *CertKeyPair) WriteFiles(certFile, keyFile string) error {
+	if err := os.WriteFile(certFile, p.CertPem, 0644); err != nil {
+		return fmt.Errorf("failed to write the certificate to %s: %w", certFile, err)
+	}
+	if err := os.WriteFile(keyFile, p.KeyPem, 0600); err != nil {
+		return fmt.Errorf("failed to write the private key to %s: %w", keyFile, err)
+	}
+
+	return nil
+}
+
+func newCertTemplate(commonName string, validFor time.Duration) (*x509.Certificate, error) {
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the serial number: %w", err)
+	}
+
+	now := time.Now()
+	return &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(validFor),
+	}, nil
+}
+
+// createCertKeyPair generates a key and the certificate of the given template
+// signed by the given CA, or self-signed if the CA is nil
+func createCertKeyPair(tmpl *x509.Certificate, ca *CertKeyPair) (*CertKeyPair, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the private key: %w", err)
+	}
+
+	parent, signer := tmpl, key
+	if ca != nil {
+		parent, signer = ca.cert, ca.key
+	}
+
+	certDer, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, signer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the certificate: %w", err)
+	}
+	cert, err := x509.ParseCertificate(certDer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the certificate: %w", err)
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the private key: %w", err)
+	}
+
+	return &CertKeyPair{
+		CertPem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDer}),
+		KeyPem:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}),
+		cert:    cert,
+		key:     key,
+	}, nil
+}